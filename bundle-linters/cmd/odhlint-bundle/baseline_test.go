@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// copyBundle copies a testdata bundle to a temporary directory the test
+// can modify, and returns its path
+func copyBundle(t *testing.T, name string) string {
+	t.Helper()
+	return copyBundleTo(t, name, filepath.Join(t.TempDir(), name))
+}
+
+// copyBundleTo copies a testdata bundle to dst and returns dst
+func copyBundleTo(t *testing.T, name, dst string) string {
+	t.Helper()
+
+	src := filepath.Join("testdata", "bundles", name)
+	err := filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, data, 0o644)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dst
+}
+
+// jsonSummaryOf decodes the summary of a single-bundle json report
+func jsonSummaryOf(t *testing.T, result cliResult) int {
+	t.Helper()
+
+	var report struct {
+		Summary struct {
+			Total int `json:"total"`
+		} `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(result.stdout), &report); err != nil {
+		t.Fatalf("stdout is not a json report: %v\n%s", err, result.stdout)
+	}
+	return report.Summary.Total
+}
+
+func TestBaselineRoundTrip(t *testing.T) {
+	bundle := copyBundle(t, "mixed")
+	baselinePath := filepath.Join(filepath.Dir(bundle), ".odhlint-baseline.json")
+
+	result := runCLI(t, "--baseline", baselinePath, "--write-baseline", bundle)
+	if result.code != int(exitClean) {
+		t.Fatalf("--write-baseline exit code = %d\nstderr:\n%s", result.code, result.stderr)
+	}
+	if !strings.Contains(result.stdout, "Wrote baseline of 5 violation(s)") {
+		t.Errorf("unexpected --write-baseline output:\n%s", result.stdout)
+	}
+
+	// Every recorded violation is suppressed
+	result = runCLI(t, "--baseline", baselinePath, "--format", "json", bundle)
+	if result.code != int(exitClean) {
+		t.Errorf("exit code with baseline = %d, want %d\nstderr:\n%s", result.code, exitClean, result.stderr)
+	}
+	if total := jsonSummaryOf(t, result); total != 0 {
+		t.Errorf("got %d violation(s) with the baseline, want 0", total)
+	}
+	if !strings.Contains(result.stderr, "Suppressed 5 baselined violation(s)") {
+		t.Errorf("suppressed count is not reported on stderr:\n%s", result.stderr)
+	}
+
+	// A violation introduced afterwards is reported and fails the run
+	pdb := `apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: example-pdb
+spec:
+  maxUnavailable: 0
+  selector:
+    matchLabels:
+      app: example-worker
+`
+	if err := os.WriteFile(filepath.Join(bundle, "manifests", "pdb.yaml"), []byte(pdb), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	result = runCLI(t, "--baseline", baselinePath, "--format", "json", bundle)
+	if result.code != int(exitFindings) {
+		t.Errorf("exit code with a new violation = %d, want %d\nstderr:\n%s", result.code, exitFindings, result.stderr)
+	}
+	if total := jsonSummaryOf(t, result); total != 1 {
+		t.Errorf("got %d violation(s) after adding one, want 1\n%s", total, result.stdout)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// categoryCount is the JSON representation of a category listing entry
+type categoryCount struct {
+	Category string `json:"category"`
+	Rules    int    `json:"rules"`
+}
+
+// printCategories lists every rule category in display order with the
+// number of registered rules in it
+func printCategories(w io.Writer, format string) error {
+	counts := make(map[rules.Category]int)
+	for _, rule := range rules.GetAllRules() {
+		counts[rule.Category()]++
+	}
+
+	var entries []categoryCount
+	for _, cat := range rules.AllCategories() {
+		entries = append(entries, categoryCount{Category: string(cat), Rules: counts[cat]})
+	}
+
+	switch format {
+	case "text":
+		for _, entry := range entries {
+			fmt.Fprintf(w, "%-20s %d rule(s)\n", entry.Category, entry.Rules)
+		}
+		return nil
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(entries)
+	}
+
+	return fmt.Errorf("unsupported format for --list-categories: %s (expected text or json)", format)
+}
+
+// parseCategoryList parses a comma-separated list of category names
+func parseCategoryList(list string) (map[rules.Category]bool, error) {
+	result := make(map[rules.Category]bool)
+
+	for name := range parseRuleList(list) {
+		found := false
+		for _, cat := range rules.AllCategories() {
+			if strings.EqualFold(name, string(cat)) {
+				result[cat] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown category %q (see --list-categories)", name)
+		}
+	}
+
+	return result, nil
+}
+
+// filterCategories keeps only rules in the selected categories. An empty
+// selection keeps every rule.
+func filterCategories(ruleList []rules.Rule, categories map[rules.Category]bool) []rules.Rule {
+	if len(categories) == 0 {
+		return ruleList
+	}
+
+	var filtered []rules.Rule
+	for _, rule := range ruleList {
+		if categories[rule.Category()] {
+			filtered = append(filtered, rule)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// escalateWarning is a config that makes the warning bundle's only
+// finding, an ODH-OLM-001 warning, an error
+const escalateWarning = `rules:
+  ODH-OLM-001:
+    severity: error
+`
+
+func TestConfigEscalatesWarning(t *testing.T) {
+	bundle := copyBundle(t, "warning")
+
+	result := runCLI(t, bundle)
+	if result.code != int(exitClean) {
+		t.Fatalf("exit code without a config = %d, want %d\nstderr:\n%s", result.code, exitClean, result.stderr)
+	}
+
+	// Discovered from the bundle directory without --config
+	if err := os.WriteFile(filepath.Join(bundle, ".odhlint.yaml"), []byte(escalateWarning), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	result = runCLI(t, "--format", "json", bundle)
+	if result.code != int(exitFindings) {
+		t.Fatalf("exit code with .odhlint.yaml = %d, want %d\nstderr:\n%s", result.code, exitFindings, result.stderr)
+	}
+	if rules, _ := jsonRuleFiles(t, result); len(rules) != 1 || rules[0] != "ODH-OLM-001" {
+		t.Errorf("reported rules = %v, want [ODH-OLM-001]", rules)
+	}
+
+	// --config disables discovery
+	empty := filepath.Join(t.TempDir(), "empty.yaml")
+	if err := os.WriteFile(empty, []byte("rules: {}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	result = runCLI(t, "--config", empty, bundle)
+	if result.code != int(exitClean) {
+		t.Errorf("exit code with --config = %d, want %d\nstderr:\n%s", result.code, exitClean, result.stderr)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDebugLogsGoToStderr(t *testing.T) {
+	result := runCLI(t, "--debug", "--format", "json", "testdata/bundles/mixed")
+	if result.code != int(exitFindings) {
+		t.Fatalf("exit code = %d\nstderr:\n%s", result.code, result.stderr)
+	}
+
+	// jsonSummaryOf fails the test unless stdout is exactly the report
+	if total := jsonSummaryOf(t, result); total != 5 {
+		t.Errorf("got %d violation(s), want 5", total)
+	}
+	if strings.Contains(result.stdout, "level=DEBUG") {
+		t.Errorf("debug logs were written to stdout:\n%s", result.stdout)
+	}
+	for _, msg := range []string{`msg="loaded file"`, `msg="resources found"`, `msg="rule evaluated"`} {
+		if !strings.Contains(result.stderr, msg) {
+			t.Errorf("stderr does not log %s:\n%s", msg, result.stderr)
+		}
+	}
+}
+
+func TestNoDebugLogsByDefault(t *testing.T) {
+	result := runCLI(t, "--format", "json", "testdata/bundles/mixed")
+	if strings.Contains(result.stderr, "level=DEBUG") {
+		t.Errorf("debug logs written without --debug:\n%s", result.stderr)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// ExitCode is the process exit status. The values are a stable contract
+// for CI scripts, which use them to tell deterministic lint findings, not
+// worth retrying, from load and setup failures, which may be transient.
+type ExitCode int
+
+const (
+	exitClean          ExitCode = 0   // No findings at or above the failure threshold
+	exitFindings       ExitCode = 1   // Lint findings at or above the failure threshold
+	exitLoadError      ExitCode = 2   // A bundle, config file or other input could not be loaded or parsed
+	exitUsage          ExitCode = 3   // Invalid flags or arguments
+	exitStrictWarnings ExitCode = 4   // Only warnings, which fail the run because of --strict
+	exitTimeout        ExitCode = 124 // The run did not finish within --timeout
+)
+
+// exit terminates the process with the given code. Every exit goes through
+// here so the codes stay within the documented set.
+func exit(code ExitCode) {
+	os.Exit(int(code))
+}
+
+// startTimeout exits with exitTimeout if the run is still going after d.
+// A zero duration means no limit.
+func startTimeout(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	time.AfterFunc(d, func() {
+		fmt.Fprintf(os.Stderr, "Error: timed out after %s\n", d)
+		exit(exitTimeout)
+	})
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/config"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestExitCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want ExitCode
+	}{
+		{"clean bundle", []string{"testdata/bundles/clean"}, exitClean},
+		{"errors", []string{"testdata/bundles/mixed"}, exitFindings},
+		{"warnings without --strict", []string{"testdata/bundles/warning"}, exitClean},
+		{"missing bundle", []string{"testdata/bundles/missing"}, exitLoadError},
+		{"load error wins over findings", []string{"testdata/bundles/mixed", "testdata/bundles/missing"}, exitLoadError},
+		{"unknown format", []string{"--format", "yaml", "testdata/bundles/clean"}, exitUsage},
+		{"no bundle", nil, exitUsage},
+		{"strict warnings", []string{"--strict", "testdata/bundles/warning"}, exitStrictWarnings},
+		{"strict with errors", []string{"--strict", "testdata/bundles/mixed"}, exitFindings},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := runCLI(t, tt.args...)
+			if ExitCode(result.code) != tt.want {
+				t.Errorf("exit code = %d, want %d\nstderr:\n%s", result.code, tt.want, result.stderr)
+			}
+		})
+	}
+}
+
+// violationsOf returns errors and warnings of a placeholder rule
+func violationsOf(errors, warnings int) []rules.Violation {
+	var violations []rules.Violation
+	for i := 0; i < errors; i++ {
+		violations = append(violations, rules.Violation{RuleID: "ODH-OLM-004", Severity: rules.SeverityError})
+	}
+	for i := 0; i < warnings; i++ {
+		violations = append(violations, rules.Violation{RuleID: "ODH-OLM-001", Severity: rules.SeverityWarning})
+	}
+	return violations
+}
+
+func TestExitCodeFor(t *testing.T) {
+	tests := []struct {
+		name         string
+		errors       int
+		warnings     int
+		strict       bool
+		noWarnings   bool
+		maxErrors    int
+		maxWarnings  int
+		want         ExitCode
+		wantExceeded int
+	}{
+		{name: "clean", maxErrors: -1, maxWarnings: -1, want: exitClean},
+		{name: "errors", errors: 1, maxErrors: -1, maxWarnings: -1, want: exitFindings},
+		{name: "warnings", warnings: 3, maxErrors: -1, maxWarnings: -1, want: exitClean},
+		{name: "warnings at --max-warnings", warnings: 3, maxErrors: -1, maxWarnings: 3, want: exitClean},
+		{name: "warnings over --max-warnings", warnings: 4, maxErrors: -1, maxWarnings: 3, want: exitFindings, wantExceeded: 1},
+		{name: "--max-warnings 0", warnings: 1, maxErrors: -1, maxWarnings: 0, want: exitFindings, wantExceeded: 1},
+		{name: "--max-warnings ignored with --no-warnings", warnings: 4, noWarnings: true, maxErrors: -1, maxWarnings: 3, want: exitClean},
+		{name: "errors at --max-errors", errors: 2, maxErrors: 2, maxWarnings: -1, want: exitClean},
+		{name: "errors over --max-errors", errors: 3, maxErrors: 2, maxWarnings: -1, want: exitFindings, wantExceeded: 1},
+		{name: "both budgets exceeded", errors: 3, warnings: 4, maxErrors: 2, maxWarnings: 3, want: exitFindings, wantExceeded: 2},
+		{name: "strict warnings", warnings: 1, strict: true, maxErrors: -1, maxWarnings: -1, want: exitStrictWarnings},
+		{name: "strict without warnings", strict: true, maxErrors: -1, maxWarnings: -1, want: exitClean},
+		{name: "strict with errors", errors: 1, warnings: 1, strict: true, maxErrors: -1, maxWarnings: -1, want: exitFindings},
+		{name: "strict warnings at --max-warnings", warnings: 3, strict: true, maxErrors: -1, maxWarnings: 3, want: exitStrictWarnings},
+		{name: "strict warnings over --max-warnings", warnings: 4, strict: true, maxErrors: -1, maxWarnings: 3, want: exitFindings, wantExceeded: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := lintOptions{
+				strict:      tt.strict,
+				noWarnings:  tt.noWarnings,
+				maxErrors:   tt.maxErrors,
+				maxWarnings: tt.maxWarnings,
+			}
+			got, exceeded := exitCodeFor(violationsOf(tt.errors, tt.warnings), opts)
+			if got != tt.want {
+				t.Errorf("exit code = %d, want %d", got, tt.want)
+			}
+			if len(exceeded) != tt.wantExceeded {
+				t.Errorf("exceeded = %q, want %d reason(s)", exceeded, tt.wantExceeded)
+			}
+		})
+	}
+}
+
+func TestEscalatedWarningExitCode(t *testing.T) {
+	opts := lintOptions{maxErrors: -1, maxWarnings: -1}
+	violations := violationsOf(0, 1)
+	if got, _ := exitCodeFor(violations, opts); got != exitClean {
+		t.Fatalf("exit code before escalation = %d, want %d", got, exitClean)
+	}
+
+	cfg := &config.Config{RuleOverrides: map[string]config.RuleOverride{"ODH-OLM-001": {Severity: "error"}}}
+	cfg.ApplySeverities(violations)
+	if got, _ := exitCodeFor(violations, opts); got != exitFindings {
+		t.Errorf("exit code after escalation = %d, want %d", got, exitFindings)
+	}
+}
@@ -0,0 +1,33 @@
+//go:build unix
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+// TestExitCodeTimeout lints a bundle whose manifest is a named pipe nobody
+// writes to, so loading blocks until --timeout ends the run
+func TestExitCodeTimeout(t *testing.T) {
+	bundle := t.TempDir()
+	for _, dir := range []string{"manifests", "metadata"} {
+		if err := os.Mkdir(filepath.Join(bundle, dir), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := syscall.Mkfifo(filepath.Join(bundle, "manifests", "blocked.yaml"), 0o644); err != nil {
+		t.Skipf("cannot create a named pipe: %v", err)
+	}
+
+	result := runCLI(t, "--timeout", "200ms", bundle)
+	if ExitCode(result.code) != exitTimeout {
+		t.Errorf("exit code = %d, want %d\nstderr:\n%s", result.code, exitTimeout, result.stderr)
+	}
+	if !strings.Contains(result.stderr, "timed out after 200ms") {
+		t.Errorf("stderr does not report the timeout:\n%s", result.stderr)
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// printExplanations writes the full documentation of every rule, grouped by
+// category and ordered by rule ID, in text or markdown format
+func printExplanations(w io.Writer, format string) error {
+	var write func(io.Writer, rules.Rule)
+	switch format {
+	case "text":
+		write = writeTextExplanation
+	case "markdown", "md":
+		write = writeMarkdownExplanation
+	default:
+		return fmt.Errorf("unsupported format for --explain-all: %s (expected text or markdown)", format)
+	}
+
+	for _, cat := range rules.AllCategories() {
+		ruleList := rulesInCategory(cat)
+		if len(ruleList) == 0 {
+			continue
+		}
+
+		if format == "text" {
+			fmt.Fprintf(w, "=== %s ===\n\n", cat)
+		} else {
+			fmt.Fprintf(w, "## %s\n\n", cat)
+		}
+
+		for _, rule := range ruleList {
+			write(w, rule)
+		}
+	}
+
+	return nil
+}
+
+// rulesInCategory returns the registered rules of a category sorted by ID
+func rulesInCategory(cat rules.Category) []rules.Rule {
+	var ruleList []rules.Rule
+	for _, rule := range rules.GetAllRules() {
+		if rule.Category() == cat {
+			ruleList = append(ruleList, rule)
+		}
+	}
+	sort.Slice(ruleList, func(i, j int) bool {
+		return ruleList[i].ID() < ruleList[j].ID()
+	})
+	return ruleList
+}
+
+// explanationOf returns the extended documentation of a rule, if it has any
+func explanationOf(rule rules.Rule) rules.Explanation {
+	if explainer, ok := rule.(rules.Explainer); ok {
+		return explainer.Explain()
+	}
+	return rules.Explanation{}
+}
+
+func writeTextExplanation(w io.Writer, rule rules.Rule) {
+	exp := explanationOf(rule)
+
+	fmt.Fprintf(w, "%s: %s\n", rule.ID(), rule.Name())
+	fmt.Fprintf(w, "  Severity: %s\n", rule.Severity())
+	fmt.Fprintf(w, "  Fixable:  %t\n\n", rule.Fixable())
+	fmt.Fprintf(w, "  %s\n\n", rule.Description())
+
+	if exp.Remediation != "" {
+		fmt.Fprintf(w, "  Remediation: %s\n\n", exp.Remediation)
+	}
+	if exp.BadExample != "" {
+		fmt.Fprintf(w, "  Bad:\n%s\n\n", indent(exp.BadExample, "    "))
+	}
+	if exp.GoodExample != "" {
+		fmt.Fprintf(w, "  Good:\n%s\n\n", indent(exp.GoodExample, "    "))
+	}
+	if exp.DocsURL != "" {
+		fmt.Fprintf(w, "  Docs: %s\n\n", exp.DocsURL)
+	}
+}
+
+func writeMarkdownExplanation(w io.Writer, rule rules.Rule) {
+	exp := explanationOf(rule)
+
+	fmt.Fprintf(w, "### %s: %s\n\n", rule.ID(), rule.Name())
+	fmt.Fprintf(w, "**Severity**: %s | **Fixable**: %t\n\n", rule.Severity(), rule.Fixable())
+	fmt.Fprintf(w, "%s\n\n", rule.Description())
+
+	if exp.Remediation != "" {
+		fmt.Fprintf(w, "**Remediation**: %s\n\n", exp.Remediation)
+	}
+	if exp.BadExample != "" {
+		fmt.Fprintf(w, "**Bad**:\n```yaml\n%s\n```\n\n", exp.BadExample)
+	}
+	if exp.GoodExample != "" {
+		fmt.Fprintf(w, "**Good**:\n```yaml\n%s\n```\n\n", exp.GoodExample)
+	}
+	if exp.DocsURL != "" {
+		fmt.Fprintf(w, "**Docs**: <%s>\n\n", exp.DocsURL)
+	}
+}
+
+// indent prefixes every line of s with prefix
+func indent(s, prefix string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
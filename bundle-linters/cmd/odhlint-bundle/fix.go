@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/config"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/fixer"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/loader"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// fixBundle applies automatic fixes for a bundle's violations, then reloads
+// and re-validates the bundle so the returned violations are the ones that
+// remain after fixing
+func fixBundle(bundlePath string, loadOpts loader.Options, rulesToRun []rules.Rule, effective *config.Config, before []rules.Violation, opts lintOptions) ([]rules.Violation, fixer.Summary, error) {
+	if isBundleImage(bundlePath) {
+		return before, fixer.Summarize(before, before), fmt.Errorf("--fix is not supported for bundle images")
+	}
+
+	files, err := fixer.Apply(before)
+	for _, file := range files {
+		fmt.Fprintf(opts.progress, "Fixed: %s\n", file)
+	}
+	if err != nil {
+		return before, fixer.Summarize(before, before), err
+	}
+	if len(files) == 0 {
+		return before, fixer.Summarize(before, before), nil
+	}
+
+	// Re-validate the fixed files
+	bundle, err := loadBundle(bundlePath, loadOpts, opts)
+	if err != nil {
+		return before, fixer.Summarize(before, before), fmt.Errorf("failed to reload fixed bundle: %w", err)
+	}
+	after := rules.ValidateBundle(bundle, rulesToRun)
+	effective.ApplySeverities(after)
+
+	fmt.Fprintln(opts.progress)
+	return after, fixer.Summarize(before, after), nil
+}
+
+// printFixSummary lists the violations that were fixed and reports how many
+// remain
+func printFixSummary(summary fixer.Summary, opts lintOptions) {
+	if len(summary.Resolved) > 0 {
+		fmt.Fprintln(opts.progress, "\nFixed violations:")
+		for _, v := range summary.Resolved {
+			fmt.Fprintf(opts.progress, "  ✓ [%s] %s\n", v.RuleID, v.Message)
+			if v.Line > 0 {
+				fmt.Fprintf(opts.progress, "    %s:%d\n", v.File, v.Line)
+			} else if v.File != "" {
+				fmt.Fprintf(opts.progress, "    %s\n", v.File)
+			}
+		}
+	}
+	fmt.Fprintf(opts.progress, "\nFix summary: %d fixed, %d remaining (%d not auto-fixable)\n",
+		summary.Fixed, summary.Remaining, summary.NotFixable)
+}
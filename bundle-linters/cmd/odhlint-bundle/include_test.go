@@ -0,0 +1,145 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// filteredBundle returns a copy of the clean bundle with a CRD the CSV
+// does not own and a sample file that fails to parse
+func filteredBundle(t *testing.T) string {
+	t.Helper()
+
+	bundle := copyBundle(t, "clean")
+	crd := `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  scope: Namespaced
+  names:
+    kind: Widget
+    plural: widgets
+    singular: widget
+  versions:
+  - name: v1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        type: object
+`
+	files := map[string]string{
+		"widgets.crd.yaml":    crd,
+		"widgets.sample.yaml": "spec: [unclosed\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(bundle, "manifests", name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return bundle
+}
+
+// jsonRuleFiles decodes a single-bundle json report into the IDs of the
+// reported rules and the base names of the files they were reported in
+func jsonRuleFiles(t *testing.T, result cliResult) ([]string, map[string]bool) {
+	t.Helper()
+
+	var report struct {
+		Violations []struct {
+			RuleID string `json:"ruleId"`
+			File   string `json:"file"`
+		} `json:"violations"`
+	}
+	if err := json.Unmarshal([]byte(result.stdout), &report); err != nil {
+		t.Fatalf("stdout is not a json report: %v\n%s", err, result.stdout)
+	}
+	var ids []string
+	files := make(map[string]bool)
+	for _, v := range report.Violations {
+		ids = append(ids, v.RuleID)
+		files[filepath.Base(v.File)] = true
+	}
+	return ids, files
+}
+
+func TestIncludeExclude(t *testing.T) {
+	bundle := filteredBundle(t)
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	relative, err := filepath.Rel(wd, bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Unfiltered, the sample file fails to load
+	if result := runCLI(t, "--format", "json", bundle); result.code != int(exitLoadError) {
+		t.Fatalf("exit code without filters = %d, want %d\nstderr:\n%s", result.code, exitLoadError, result.stderr)
+	}
+
+	tests := []struct {
+		name      string
+		args      []string
+		wantRules []string
+		wantFiles []string
+	}{
+		{
+			// Without the CSV, the owned CRD check is skipped
+			name:      "only CRDs",
+			args:      []string{"--include", "*.crd.yaml", bundle},
+			wantRules: []string{"ODH-OLM-022"},
+			wantFiles: []string{"widgets.crd.yaml"},
+		},
+		{
+			name:      "only CRDs, relative bundle path",
+			args:      []string{"--include", "*.crd.yaml", relative + string(filepath.Separator)},
+			wantRules: []string{"ODH-OLM-022"},
+			wantFiles: []string{"widgets.crd.yaml"},
+		},
+		{
+			name:      "only CRDs with **",
+			args:      []string{"--include", "**/*.crd.yaml", bundle},
+			wantRules: []string{"ODH-OLM-022"},
+			wantFiles: []string{"widgets.crd.yaml"},
+		},
+		{
+			name:      "exclude wins over include",
+			args:      []string{"--include", "*.yaml", "--exclude", "*.sample.yaml", bundle},
+			wantRules: []string{"ODH-OLM-022", "ODH-OLM-040"},
+			wantFiles: []string{"widgets.crd.yaml"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := runCLI(t, append([]string{"--format", "json"}, tt.args...)...)
+			if result.code != int(exitClean) {
+				t.Fatalf("exit code = %d, want %d\nstderr:\n%s", result.code, exitClean, result.stderr)
+			}
+			ids, files := jsonRuleFiles(t, result)
+			if len(ids) != len(tt.wantRules) {
+				t.Fatalf("reported %q, want %q", ids, tt.wantRules)
+			}
+			for i, id := range tt.wantRules {
+				if ids[i] != id {
+					t.Errorf("reported %q, want %q", ids, tt.wantRules)
+					break
+				}
+			}
+			for _, file := range tt.wantFiles {
+				if !files[file] {
+					t.Errorf("no violation reported in %s: %v", file, files)
+				}
+			}
+			if len(files) != len(tt.wantFiles) {
+				t.Errorf("violations reported in %v, want only %q", files, tt.wantFiles)
+			}
+		})
+	}
+}
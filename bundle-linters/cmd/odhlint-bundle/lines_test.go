@@ -0,0 +1,35 @@
+package main
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestViolationsReportSourceLines(t *testing.T) {
+	result := runCLI(t, "--format", "json", "testdata/bundles/mixed")
+	if result.code != int(exitFindings) {
+		t.Fatalf("exit code = %d\nstderr:\n%s", result.code, result.stderr)
+	}
+
+	var report struct {
+		Violations []struct {
+			RuleID string `json:"ruleId"`
+			Line   int    `json:"line"`
+		} `json:"violations"`
+	}
+	if err := json.Unmarshal([]byte(result.stdout), &report); err != nil {
+		t.Fatalf("stdout is not a json report: %v\n%s", err, result.stdout)
+	}
+
+	// The CSV's containerImage annotation and the manager container's image
+	var lines []int
+	for _, v := range report.Violations {
+		if v.RuleID == "ODH-OLM-034" {
+			lines = append(lines, v.Line)
+		}
+	}
+	if want := []int{8, 47}; !slices.Equal(lines, want) {
+		t.Errorf("ODH-OLM-034 reported at lines %v, want %v", lines, want)
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// ruleInfo is the JSON representation of a rule listing entry
+type ruleInfo struct {
+	ID          string `json:"ruleId"`
+	Name        string `json:"ruleName"`
+	Category    string `json:"category"`
+	Severity    string `json:"severity"`
+	Description string `json:"description"`
+	Fixable     bool   `json:"fixable"`
+}
+
+// printRules prints all available rules, grouped by category as text or as
+// a flat JSON array in registry order
+func printRules(w io.Writer, format string) error {
+	allRules := rules.GetAllRules()
+
+	switch format {
+	case "text":
+		printRulesText(w, allRules)
+		return nil
+	case "json":
+		entries := make([]ruleInfo, 0, len(allRules))
+		for _, rule := range allRules {
+			entries = append(entries, ruleInfo{
+				ID:          rule.ID(),
+				Name:        rule.Name(),
+				Category:    string(rule.Category()),
+				Severity:    string(rule.Severity()),
+				Description: rule.Description(),
+				Fixable:     rule.Fixable(),
+			})
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(entries)
+	}
+
+	return fmt.Errorf("unsupported format for --list-rules: %s (expected text or json)", format)
+}
+
+// printRulesText prints the rules grouped by category
+func printRulesText(w io.Writer, allRules []rules.Rule) {
+	fmt.Fprintln(w, "Available validation rules:")
+	fmt.Fprintln(w)
+
+	// Group by category
+	categories := make(map[rules.Category][]rules.Rule)
+	for _, rule := range allRules {
+		cat := rule.Category()
+		categories[cat] = append(categories[cat], rule)
+	}
+
+	// Print by category
+	for _, cat := range rules.AllCategories() {
+		if ruleList, ok := categories[cat]; ok && len(ruleList) > 0 {
+			fmt.Fprintf(w, "=== %s ===\n\n", cat)
+			for _, rule := range ruleList {
+				fmt.Fprintf(w, "  %s: %s\n", rule.ID(), rule.Name())
+				fmt.Fprintf(w, "    Severity: %s\n", rule.Severity())
+				fmt.Fprintf(w, "    %s\n", rule.Description())
+				fmt.Fprintln(w)
+			}
+		}
+	}
+
+	fmt.Fprintf(w, "Total: %d rules\n", len(allRules))
+}
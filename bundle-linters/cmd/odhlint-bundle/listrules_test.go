@@ -0,0 +1,39 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestListRulesJSON(t *testing.T) {
+	result := runCLI(t, "--list-rules", "--format", "json")
+	if result.code != int(exitClean) {
+		t.Fatalf("exit code = %d\nstderr:\n%s", result.code, result.stderr)
+	}
+
+	var entries []ruleInfo
+	if err := json.Unmarshal([]byte(result.stdout), &entries); err != nil {
+		t.Fatalf("stdout is not a json rule list: %v\n%s", err, result.stdout)
+	}
+
+	listed := make(map[string]ruleInfo, len(entries))
+	for _, entry := range entries {
+		listed[entry.ID] = entry
+	}
+	allRules := rules.GetAllRules()
+	if len(entries) != len(allRules) {
+		t.Errorf("listed %d rules, want %d", len(entries), len(allRules))
+	}
+	for _, rule := range allRules {
+		entry, ok := listed[rule.ID()]
+		if !ok {
+			t.Errorf("%s is not listed", rule.ID())
+			continue
+		}
+		if entry.Name != rule.Name() || entry.Category != string(rule.Category()) || entry.Severity != string(rule.Severity()) {
+			t.Errorf("%s listed as %+v", rule.ID(), entry)
+		}
+	}
+}
@@ -3,12 +3,21 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"path"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/baseline"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/config"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/fixer"
 	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/loader"
 	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/reporter"
 	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/schema"
 )
 
 const version = "1.0.0"
@@ -16,124 +25,677 @@ const version = "1.0.0"
 func main() {
 	// Command line flags
 	listRules := flag.Bool("list-rules", false, "List all available rules")
+	listCategories := flag.Bool("list-categories", false, "List rule categories with the number of rules in each")
+	explainAll := flag.Bool("explain-all", false, "Print the full documentation for every rule")
+	format := flag.String("format", "text", "Output format: text, json, jsonl, sarif, github or junit (text or markdown with --explain-all, text or json with --list-rules and --list-categories)")
+	noColor := flag.Bool("no-color", false, "Disable colored text output (color is only used when stdout is a terminal and NO_COLOR is unset)")
+	groupBy := flag.String("group-by", "severity", "How the text format arranges violations: severity (every violation, most severe first) or rule (one entry per rule with its count and locations)")
 	enableRules := flag.String("enable", "", "Comma-separated list of rule IDs to enable (default: all)")
 	disableRules := flag.String("disable", "", "Comma-separated list of rule IDs to disable")
+	demoteRules := flag.String("demote", "", "Comma-separated list of rule IDs to report as info, overriding configured severities")
+	categoryFilter := flag.String("category", "", "Comma-separated list of rule categories to run (default: all)")
 	showVersion := flag.Bool("version", false, "Show version information")
 	noWarnings := flag.Bool("no-warnings", false, "Treat warnings as passing (exit 0)")
+	strict := flag.Bool("strict", false, "Treat warnings as errors: any warning fails the run (exit 4 when there are no errors)")
+	quietPassing := flag.Bool("quiet-passing", false, "Print nothing when the run passes (exit 0); show the full output only when it fails")
+	quiet := flag.Bool("quiet", false, "Print only failing violations, one line each, without progress messages or a summary; the exit code is unchanged")
+	maxErrors := flag.Int("max-errors", -1, "Fail only when more than N error-severity violations are found in total (-1: any error fails)")
+	maxWarnings := flag.Int("max-warnings", -1, "Fail when more than N warnings are found in total (-1: unlimited)")
+	var configPaths stringList
+	var outputSpecs stringList
+	var includePatterns stringList
+	var excludePatterns stringList
+	flag.Var(&configPaths, "config", "Path to a YAML config file with file patterns and rule overrides (repeatable; later files override earlier ones; default: .odhlint.yaml in each bundle directory)")
+	flag.Var(&includePatterns, "include", "Load only the manifest files matching this glob, relative to manifests/, e.g. '*.crd.yaml' (repeatable; replaces the config file's include list)")
+	flag.Var(&excludePatterns, "exclude", "Skip the manifest files matching this glob, relative to manifests/ (repeatable; added to the config file's exclude list)")
+	flag.Var(&outputSpecs, "output", "Also write the report to a file in another format, as format=path, e.g. json=report.json (repeatable)")
+	csvSchemaPath := flag.String("csv-schema", "", "Path to a JSON Schema (JSON or YAML) the CSV must satisfy")
+	recursive := flag.Bool("recursive", false, "Lint every bundle (a directory with manifests/ and metadata/) found below each directory argument")
+	yamlLint := flag.Bool("yaml-lint", false, "Check manifest files for tab indentation and duplicate keys before parsing (ODH-OLM-038)")
+	pathPrefixStrip := flag.String("path-prefix-strip", "", "Remove this prefix from reported file paths, e.g. the repository root")
+	pathPrefixAdd := flag.String("path-prefix-add", "", "Prepend this prefix to reported file paths (applied after --path-prefix-strip)")
+	baselinePath := flag.String("baseline", "", "Suppress violations recorded in this baseline file: only new violations are reported and fail the run")
+	writeBaseline := flag.Bool("write-baseline", false, "Record the current violations to the --baseline file instead of gating")
+	baselineCountsPath := flag.String("baseline-counts", "", "Gate on per-rule violation counts recorded in this file: fail only when a count exceeds its baseline")
+	writeBaselineCounts := flag.Bool("write-baseline-counts", false, "Record the current per-rule violation counts to the --baseline-counts file instead of gating")
+	failFast := flag.Bool("fail-fast", false, "Stop at the first rule that reports an error-severity violation")
+	showSkipped := flag.Bool("show-skipped", false, "List the rules that ran but do not apply to the bundle, with the reason")
+	fix := flag.Bool("fix", false, "Apply automatic fixes in place, then report the violations that remain")
+	registryAuth := flag.String("registry-auth", "", "Registry credentials as user:password for docker:// bundle images (default: docker config file)")
+	registryToken := flag.String("registry-token", "", "Bearer token for docker:// bundle images, used instead of credentials")
+	insecure := flag.Bool("insecure", false, "Pull docker:// bundle images over plain HTTP and skip TLS verification")
+	registryRetries := flag.Int("registry-retries", 3, "Number of retries for transient registry failures")
+	registryBackoff := flag.Duration("registry-backoff", time.Second, "Initial delay between registry retries, doubled after each attempt")
+	debug := flag.Bool("debug", false, "Log the linter's own operation to stderr: files loaded and the parser for each, resource counts by kind, and each rule's result and duration")
+	timeout := flag.Duration("timeout", 0, "Exit with code 124 if the run takes longer than this, e.g. 5m (0: no limit)")
 	
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: %s [options] <bundle-path>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Usage: %s [options] <bundle-path|manifest.yaml|docker://image|image.tar>...\n\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "odhlint-bundle validates Operator Lifecycle Manager (OLM) bundles against best practices and requirements.\n\n")
 		fmt.Fprintf(os.Stderr, "Options:\n")
 		flag.PrintDefaults()
 		fmt.Fprintf(os.Stderr, "\nExamples:\n")
 		fmt.Fprintf(os.Stderr, "  %s ./bundle/\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "  %s --list-rules\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --explain-all --format markdown > rules.md\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "  %s --enable ODH-OLM-001,ODH-OLM-002 ./bundle/\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "  %s --disable ODH-OLM-007 ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --demote ODH-OLM-018,ODH-OLM-023 ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --category OLM-Security,OLM-Upgrade ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --config .odhlint.yaml bundles/prod bundles/experimental\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --config org-base.yaml --config .odhlint.yaml ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --include '*.crd.yaml' ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --format jsonl ./bundle/ | jq .\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --output json=report.json ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --group-by rule bundles/*/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --recursive bundles/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --fix ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --fail-fast ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --strict ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --quiet-passing ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --quiet --recursive bundles/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --timeout 5m docker://quay.io/org/my-operator-bundle:v1.0.0\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --baseline .odhlint-baseline.json --write-baseline ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --baseline-counts .odhlint-counts.yaml --write-baseline-counts ./bundle/\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s docker://quay.io/org/my-operator-bundle:v1.0.0\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s my-operator-bundle.tar\n", os.Args[0])
 	}
 
-	flag.Parse()
+	// Bad flags are usage errors (exit 3), not the flag package's default 2
+	flag.CommandLine.Init(os.Args[0], flag.ContinueOnError)
+	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
+		if err == flag.ErrHelp {
+			exit(exitClean)
+		}
+		exit(exitUsage)
+	}
 
 	// Handle --version
 	if *showVersion {
 		fmt.Printf("odhlint-bundle version %s\n", version)
-		os.Exit(0)
+		exit(exitClean)
 	}
 
 	// Handle --list-rules
 	if *listRules {
-		printRules()
-		os.Exit(0)
+		if err := printRules(os.Stdout, *format); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			exit(exitUsage)
+		}
+		exit(exitClean)
+	}
+
+	// Handle --list-categories
+	if *listCategories {
+		if err := printCategories(os.Stdout, *format); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			exit(exitUsage)
+		}
+		exit(exitClean)
+	}
+
+	// Handle --explain-all
+	if *explainAll {
+		if err := printExplanations(os.Stdout, *format); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			exit(exitUsage)
+		}
+		exit(exitClean)
 	}
 
 	// Validate arguments
 	if flag.NArg() < 1 {
 		fmt.Fprintf(os.Stderr, "Error: bundle path is required\n\n")
 		flag.Usage()
-		os.Exit(1)
+		exit(exitUsage)
+	}
+
+	if *strict && *noWarnings {
+		fmt.Fprintf(os.Stderr, "Error: --strict and --no-warnings are mutually exclusive\n")
+		exit(exitUsage)
+	}
+
+	startTimeout(*timeout)
+
+	// Load and merge the config files, if any
+	cfg, err := config.LoadAll(configPaths)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		exit(exitLoadError)
+	}
+
+	// Load the CSV schema, if any
+	var csvSchema *schema.Schema
+	if *csvSchemaPath != "" {
+		loaded, err := schema.Load(*csvSchemaPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading CSV schema: %v\n", err)
+			exit(exitLoadError)
+		}
+		csvSchema = loaded
+	}
+
+	categories, err := parseCategoryList(*categoryFilter)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(exitUsage)
+	}
+
+	// Load the violations baseline, unless it is about to be written
+	var accepted *baseline.Accepted
+	if *writeBaseline && *baselinePath == "" {
+		fmt.Fprintf(os.Stderr, "Error: --write-baseline requires --baseline <file>\n")
+		exit(exitUsage)
+	}
+	if *baselinePath != "" && !*writeBaseline {
+		accepted, err = baseline.LoadAccepted(*baselinePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			exit(exitLoadError)
+		}
+	}
+
+	// Load the counts baseline, unless it is about to be written
+	var baselineCounts baseline.Counts
+	if *writeBaselineCounts && *baselineCountsPath == "" {
+		fmt.Fprintf(os.Stderr, "Error: --write-baseline-counts requires --baseline-counts <file>\n")
+		exit(exitUsage)
+	}
+	if *baselineCountsPath != "" && !*writeBaselineCounts {
+		baselineCounts, err = baseline.LoadCounts(*baselineCountsPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			exit(exitLoadError)
+		}
+	}
+
+	demoted := parseRuleList(*demoteRules)
+	if err := checkRuleIDs(demoted); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: --demote: %v\n", err)
+		exit(exitUsage)
+	}
+
+	if err := checkPatterns(includePatterns); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: --include: %v\n", err)
+		exit(exitUsage)
+	}
+	if err := checkPatterns(excludePatterns); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: --exclude: %v\n", err)
+		exit(exitUsage)
+	}
+
+	outputFormat, err := reporter.ParseFormat(*format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(exitUsage)
+	}
+
+	grouping, err := reporter.ParseGroupBy(*groupBy)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(exitUsage)
+	}
+
+	outputs, err := openOutputs(outputSpecs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(exitUsage)
+	}
+
+	registryOpts := loader.RegistryOptions{
+		Token:        *registryToken,
+		Insecure:     *insecure,
+		Retries:      *registryRetries,
+		RetryBackoff: *registryBackoff,
+	}
+	if *registryAuth != "" {
+		username, password, ok := strings.Cut(*registryAuth, ":")
+		if !ok || username == "" {
+			fmt.Fprintf(os.Stderr, "Error: --registry-auth must be in the form user:password\n")
+			exit(exitUsage)
+		}
+		registryOpts.Username = username
+		registryOpts.Password = password
+	}
+
+	opts := lintOptions{
+		enableRules:    *enableRules,
+		disableRules:   *disableRules,
+		demote:         demoted,
+		include:        includePatterns,
+		exclude:        excludePatterns,
+		categories:     categories,
+		noWarnings:     *noWarnings,
+		strict:         *strict,
+		quiet:          *quiet,
+		noColor:        *noColor,
+		maxErrors:      *maxErrors,
+		maxWarnings:    *maxWarnings,
+		format:         outputFormat,
+		stdout:         os.Stdout,
+		progress:       os.Stdout,
+		csvSchema:      csvSchema,
+		yamlLint:       *yamlLint,
+		registry:       registryOpts,
+		fix:            *fix,
+		failFast:       *failFast,
+		showSkipped:    *showSkipped,
+		baseline:       accepted,
+		baselineCounts: baselineCounts,
+		outputs:        outputs,
+		document:       reporter.NewDocument(),
+		groupBy:        grouping,
+		paths: reporter.PathOptions{
+			StripPrefix: *pathPrefixStrip,
+			AddPrefix:   *pathPrefixAdd,
+		},
+	}
+	if outputFormat != reporter.FormatText {
+		// Keep stdout parseable for machine-readable formats
+		opts.progress = os.Stderr
+	}
+	if *debug {
+		opts.logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	}
+
+	// With --quiet-passing, hold all output back until the outcome is known
+	var held *heldOutput
+	if *quietPassing {
+		held = holdOutput(&opts)
+	}
+	if *quiet {
+		opts.progress = io.Discard
+	}
+
+	bundlePaths := flag.Args()
+	if *recursive {
+		bundlePaths, err = discoverBundlePaths(bundlePaths)
+		if err != nil {
+			held.flush()
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			exit(exitLoadError)
+		}
+		fmt.Fprintf(opts.progress, "Discovered %d bundle(s)\n\n", len(bundlePaths))
+	}
+
+	var allViolations []rules.Violation
+	var aggregate reporter.AggregateSummary
+	failed := false
+	for i, bundlePath := range bundlePaths {
+		if i > 0 {
+			fmt.Fprintln(opts.progress)
+		}
+		bundleCfg := cfg
+		if len(configPaths) == 0 && !isBundleImage(bundlePath) {
+			if path := config.Discover(bundlePath); path != "" {
+				discovered, err := config.Load(path)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+					failed = true
+					aggregate.AddUnlinted()
+					continue
+				}
+				fmt.Fprintf(opts.progress, "Using config: %s\n", path)
+				bundleCfg = discovered
+			}
+		}
+		result, ok := lintBundle(bundlePath, bundleCfg, opts)
+		if !ok {
+			failed = true
+			aggregate.AddUnlinted()
+			continue
+		}
+		allViolations = append(allViolations, result.violations...)
+		aggregate.Add(result.violations, opts.strict)
+
+		if result.stoppedBy != "" {
+			if remaining := len(bundlePaths) - i - 1; remaining > 0 {
+				fmt.Fprintf(opts.progress, "Skipping %d remaining bundle(s) (--fail-fast)\n", remaining)
+			}
+			break
+		}
 	}
 
-	bundlePath := flag.Arg(0)
+	if !writeDocuments(opts) {
+		failed = true
+	}
+	if len(bundlePaths) > 1 {
+		reportAggregate(aggregate, opts)
+	}
+
+	closeOutputs(opts.outputs)
+
+	if *writeBaseline || *writeBaselineCounts {
+		if *writeBaseline {
+			if err := baseline.WriteAccepted(*baselinePath, allViolations); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing baseline: %v\n", err)
+				exit(exitLoadError)
+			}
+			fmt.Fprintf(opts.progress, "\nWrote baseline of %d violation(s) to %s\n", len(allViolations), *baselinePath)
+		}
+		if *writeBaselineCounts {
+			if err := baseline.CountViolations(allViolations).Write(*baselineCountsPath); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing baseline counts: %v\n", err)
+				exit(exitLoadError)
+			}
+			fmt.Fprintf(opts.progress, "\nWrote baseline counts for %d violation(s) to %s\n", len(allViolations), *baselineCountsPath)
+		}
+		if failed {
+			held.flush()
+			exit(exitLoadError)
+		}
+		exit(exitClean)
+	}
+
+	if opts.baseline != nil {
+		if unmatched := opts.baseline.Unmatched(); unmatched > 0 {
+			fmt.Fprintf(opts.progress, "\n%d baseline entr(ies) no longer match a violation; run with --write-baseline to prune them\n", unmatched)
+		}
+	}
+
+	// Exit with appropriate code
+	exitCode, exceeded := exitCodeFor(allViolations, opts)
+	for _, reason := range exceeded {
+		fmt.Fprintf(os.Stderr, "Threshold exceeded: %s\n", reason)
+	}
+	if opts.baselineCounts != nil && checkBaselineCounts(allViolations, opts) {
+		exitCode = exitFindings
+	}
+	// A bundle that could not be loaded leaves the run incomplete, which
+	// takes precedence over the findings in the bundles that were linted
+	if failed {
+		exitCode = exitLoadError
+	}
+
+	if exitCode != exitClean {
+		held.flush()
+	}
+	exit(exitCode)
+}
+
+// exitCodeFor computes the exit code for the violations of all linted
+// bundles. Error-severity violations fail the run unless --max-errors
+// allows them or --baseline-counts gates them instead. Warnings only fail
+// the run with --strict (exitStrictWarnings) or when they exceed
+// --max-warnings. The returned reasons describe each count budget that was
+// exceeded.
+func exitCodeFor(violations []rules.Violation, opts lintOptions) (ExitCode, []string) {
+	var exceeded []string
+	exitCode := exitClean
+
+	errorCount := countSeverity(violations, rules.SeverityError)
+	if opts.maxErrors >= 0 {
+		if errorCount > opts.maxErrors {
+			exceeded = append(exceeded, fmt.Sprintf("%d error(s) found, --max-errors is %d", errorCount, opts.maxErrors))
+			exitCode = exitFindings
+		}
+	} else if opts.baselineCounts == nil && hasErrors(violations) {
+		exitCode = exitFindings
+	}
+
+	// --strict fails on any warning, gated like errors by --baseline-counts.
+	// Errors take precedence, so the strict code means warnings only.
+	if opts.strict && opts.baselineCounts == nil && hasWarnings(violations) && exitCode == exitClean {
+		exitCode = exitStrictWarnings
+	}
+
+	if !opts.noWarnings && opts.maxWarnings >= 0 && hasWarnings(violations) {
+		if warningCount := countSeverity(violations, rules.SeverityWarning); warningCount > opts.maxWarnings {
+			exceeded = append(exceeded, fmt.Sprintf("%d warning(s) found, --max-warnings is %d", warningCount, opts.maxWarnings))
+			exitCode = exitFindings
+		}
+	}
+
+	return exitCode, exceeded
+}
+
+// checkBaselineCounts compares violation counts with the --baseline-counts
+// file and reports the differences. It returns true if any error count, or
+// warning count unless --no-warnings is set, exceeds its baseline. Info
+// counts are recorded but never gate.
+func checkBaselineCounts(violations []rules.Violation, opts lintOptions) bool {
+	regressions, improvements := opts.baselineCounts.Compare(baseline.CountViolations(violations))
+
+	regressed := false
+	for _, change := range regressions {
+		if change.Severity == rules.SeverityInfo || (opts.noWarnings && change.Severity == rules.SeverityWarning) {
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "Baseline regression: %s\n", change)
+		regressed = true
+	}
+
+	for _, change := range improvements {
+		fmt.Fprintf(opts.progress, "Below baseline: %s\n", change)
+	}
+	if len(improvements) > 0 {
+		fmt.Fprintf(opts.progress, "Run with --write-baseline-counts to lower the baseline\n")
+	}
+
+	return regressed
+}
+
+// lintOptions carries command line settings shared by every bundle
+type lintOptions struct {
+	enableRules    string
+	disableRules   string
+	demote         map[string]bool         // rules forced to info severity
+	include        []string                // --include manifest patterns
+	exclude        []string                // --exclude manifest patterns
+	categories     map[rules.Category]bool // empty when all categories run
+	noWarnings     bool
+	strict         bool // warnings fail the run like errors
+	quiet          bool // only failing violations on stdout, no progress
+	noColor        bool // --no-color: never color the text report
+	maxErrors      int  // -1 when unlimited
+	maxWarnings    int  // -1 when unlimited
+	format         reporter.Format
+	stdout         io.Writer // destination for reports
+	progress       io.Writer // destination for progress messages
+	csvSchema      *schema.Schema
+	yamlLint       bool
+	logger         *slog.Logger // --debug: nil unless enabled
+	registry       loader.RegistryOptions
+	fix            bool
+	failFast       bool
+	showSkipped    bool
+	baseline       *baseline.Accepted // nil unless gating on --baseline
+	baselineCounts baseline.Counts    // nil unless gating on --baseline-counts
+	outputs        []outputFile       // additional report files
+	document       *reporter.Document // json, sarif or junit results of every bundle on stdout
+	groupBy        reporter.GroupBy
+	paths          reporter.PathOptions
+}
+
+// bundleResult is the outcome of linting a single bundle
+type bundleResult struct {
+	violations []rules.Violation
+	stoppedBy  string // Rule that triggered --fail-fast, if any
+}
+
+// lintBundle loads, validates and reports a single bundle using its
+// effective config. It returns the bundle's result, or false if the bundle
+// could not be linted.
+func lintBundle(bundlePath string, cfg *config.Config, opts lintOptions) (bundleResult, bool) {
+	effective := cfg.Resolve(bundlePath)
+	effective.Demote(opts.demote)
+	effective.FilterManifests(opts.include, opts.exclude)
 
 	// Load the bundle
-	fmt.Printf("Loading bundle from: %s\n", bundlePath)
-	bundle, err := loader.LoadBundle(bundlePath)
+	fmt.Fprintf(opts.progress, "Loading bundle from: %s\n", bundlePath)
+	loadOpts := loader.Options{
+		FileFilter: effective.ManifestFilter(),
+		YAMLLint:   opts.yamlLint,
+		Logger:     opts.logger,
+	}
+	bundle, err := loadBundle(bundlePath, loadOpts, opts)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error loading bundle: %v\n", err)
-		os.Exit(1)
+		if loader.IsAuthError(err) {
+			fmt.Fprintf(os.Stderr, "Hint: check --registry-auth/--registry-token or run 'docker login'\n")
+		} else if loader.IsNetworkError(err) {
+			fmt.Fprintf(os.Stderr, "Hint: the registry could not be reached; check connectivity or raise --registry-retries\n")
+		}
+		return bundleResult{}, false
 	}
 
-	// Determine which rules to run
-	rulesToRun := selectRules(*enableRules, *disableRules)
-	fmt.Printf("Running %d validation rule(s)...\n\n", len(rulesToRun))
+	// Determine which rules to run. An explicit --enable list takes
+	// precedence over rules disabled in the config file.
+	candidates := rules.GetAllRules()
+	if opts.enableRules == "" {
+		candidates = effective.SelectRules(candidates)
+	}
+	rulesToRun := filterCategories(selectRules(candidates, opts.enableRules, opts.disableRules), opts.categories)
+	configureRules(rulesToRun, opts)
+	if err := effective.ConfigureRules(rulesToRun); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring rules: %v\n", err)
+		return bundleResult{}, false
+	}
+	fmt.Fprintf(opts.progress, "Running %d validation rule(s)...\n\n", len(rulesToRun))
 
-	// Validate the bundle
-	violations := rules.ValidateBundle(bundle, rulesToRun)
+	// Validate the bundle. Severity overrides are applied per rule so
+	// --fail-fast sees the effective severity.
+	validation := rules.ValidateBundleWithOptions(bundle, rulesToRun, rules.ValidateOptions{
+		FailFast: opts.failFast,
+		Adjust:   effective.ApplySeverities,
+		Logger:   opts.logger,
+	})
+	violations := validation.Violations
+
+	// Apply fixes and continue with what remains
+	var fixSummary fixer.Summary
+	if opts.fix {
+		violations, fixSummary, err = fixBundle(bundlePath, loadOpts, rulesToRun, effective, violations, opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error applying fixes: %v\n", err)
+		}
+	}
+
+	// Drop violations accepted in the --baseline file, so only new ones
+	// are reported and counted
+	if opts.baseline != nil {
+		var suppressed int
+		violations, suppressed = opts.baseline.Filter(violations)
+		if suppressed > 0 {
+			fmt.Fprintf(opts.progress, "Suppressed %d baselined violation(s)\n", suppressed)
+		}
+	}
 
 	// Report results
-	rep := reporter.New(os.Stdout)
+	rep := newReporter(bundlePath, cfg, opts, skippedRules(rulesToRun, validation))
 	if err := rep.Report(violations); err != nil {
 		fmt.Fprintf(os.Stderr, "Error reporting results: %v\n", err)
-		os.Exit(1)
+		return bundleResult{}, false
 	}
 
-	// Exit with appropriate code
-	exitCode := 0
-	if hasErrors(violations) {
-		exitCode = 1
-	} else if !*noWarnings && hasWarnings(violations) {
-		exitCode = 0 // Warnings don't cause failure by default
+	// The summary's error only signals failure; the exit code is computed
+	// from all bundles' violations by exitCodeFor
+	_ = rep.ReportSummary(violations)
+
+	if opts.fix {
+		printFixSummary(fixSummary, opts)
 	}
 
-	if err := rep.ReportSummary(violations); err != nil {
-		if exitCode == 0 {
-			exitCode = 1
-		}
+	if validation.StoppedBy != "" {
+		fmt.Fprintf(opts.progress, "\nStopped early: %s reported an error (--fail-fast), %d rule(s) not run\n",
+			validation.StoppedBy, validation.Skipped)
+	}
+
+	if opts.showSkipped {
+		printSkippedRules(validation.NotApplicable, opts)
 	}
 
-	os.Exit(exitCode)
+	return bundleResult{violations: violations, stoppedBy: validation.StoppedBy}, true
 }
 
-// printRules prints all available rules
-func printRules() {
-	allRules := rules.GetAllRules()
-	
-	fmt.Println("Available validation rules:")
-	fmt.Println()
-
-	// Group by category
-	categories := make(map[rules.Category][]rules.Rule)
-	for _, rule := range allRules {
-		cat := rule.Category()
-		categories[cat] = append(categories[cat], rule)
-	}
-
-	// Print by category
-	for _, cat := range []rules.Category{
-		rules.CategoryOLMRequirement,
-		rules.CategoryOLMBestPractice,
-		rules.CategorySecurity,
-		rules.CategoryUpgrade,
-	} {
-		if ruleList, ok := categories[cat]; ok && len(ruleList) > 0 {
-			fmt.Printf("=== %s ===\n\n", cat)
-			for _, rule := range ruleList {
-				fmt.Printf("  %s: %s\n", rule.ID(), rule.Name())
-				fmt.Printf("    Severity: %s\n", rule.Severity())
-				fmt.Printf("    %s\n", rule.Description())
-				fmt.Println()
-			}
+// skippedRules maps every registered rule that did not run on a bundle to
+// the reason, for reports that list all rules (junit)
+func skippedRules(rulesToRun []rules.Rule, validation rules.ValidationResult) map[string]string {
+	skipped := make(map[string]string)
+
+	selected := make(map[string]bool, len(rulesToRun))
+	for _, rule := range rulesToRun {
+		selected[rule.ID()] = true
+	}
+	for _, rule := range rules.GetAllRules() {
+		if !selected[rule.ID()] {
+			skipped[rule.ID()] = "not enabled for this run"
 		}
 	}
 
-	fmt.Printf("Total: %d rules\n", len(allRules))
+	reason := "not run: the run stopped early"
+	if validation.StoppedBy != "" {
+		reason = fmt.Sprintf("not run: %s reported an error (--fail-fast)", validation.StoppedBy)
+	}
+	for _, rule := range rulesToRun[len(rulesToRun)-validation.Skipped:] {
+		skipped[rule.ID()] = reason
+	}
+
+	for _, skip := range validation.NotApplicable {
+		skipped[skip.RuleID] = skip.Reason
+	}
+	return skipped
+}
+
+// printSkippedRules lists the rules that did not apply to a bundle and why
+func printSkippedRules(skipped []rules.SkippedRule, opts lintOptions) {
+	if len(skipped) == 0 {
+		fmt.Fprintln(opts.progress, "\nEvery rule that ran applied to this bundle")
+		return
+	}
+	fmt.Fprintf(opts.progress, "\nSkipped %d rule(s) that do not apply to this bundle:\n", len(skipped))
+	for _, skip := range skipped {
+		fmt.Fprintf(opts.progress, "  - %s: %s\n", skip.RuleID, skip.Reason)
+	}
+}
+
+// loadBundle loads a bundle from a directory, pulls it from a registry
+// when the argument is a docker:// image reference, unpacks it when the
+// argument is an image tarball or OCI layout, or loads a lone manifest
+// when the argument is a YAML file
+func loadBundle(bundlePath string, loadOpts loader.Options, opts lintOptions) (*rules.Bundle, error) {
+	if loader.IsImageReference(bundlePath) {
+		return loader.LoadBundleFromImage(bundlePath, loader.ImageOptions{
+			Options:  loadOpts,
+			Registry: opts.registry,
+		})
+	}
+	if loader.IsArchive(bundlePath) || loader.IsOCILayout(bundlePath) {
+		return loader.LoadBundleFromArchive(bundlePath, loadOpts)
+	}
+	if loader.IsManifestFile(bundlePath) {
+		return loader.LoadManifestFile(bundlePath, loadOpts)
+	}
+	return loader.LoadBundleWithOptions(bundlePath, loadOpts)
 }
 
-// selectRules determines which rules to run based on enable/disable flags
-func selectRules(enable, disable string) []rules.Rule {
-	allRules := rules.GetAllRules()
+// isBundleImage reports whether a bundle argument names a bundle image,
+// in a registry or saved to disk, rather than a bundle directory
+func isBundleImage(bundlePath string) bool {
+	return loader.IsImageReference(bundlePath) || loader.IsArchive(bundlePath) || loader.IsOCILayout(bundlePath)
+}
 
+// discoverBundlePaths replaces each directory argument with the bundles
+// found at or below it, for --recursive. Bundle images, archives and
+// manifest files are kept as given.
+func discoverBundlePaths(args []string) ([]string, error) {
+	var paths []string
+	for _, arg := range args {
+		if isBundleImage(arg) || loader.IsManifestFile(arg) {
+			paths = append(paths, arg)
+			continue
+		}
+		found, err := loader.DiscoverBundles(arg)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, found...)
+	}
+	return paths, nil
+}
+
+// selectRules narrows the candidate rules based on enable/disable flags
+func selectRules(allRules []rules.Rule, enable, disable string) []rules.Rule {
 	// If enable is specified, start with empty set
 	if enable != "" {
 		enabledIDs := parseRuleList(enable)
@@ -164,6 +726,59 @@ func selectRules(enable, disable string) []rules.Rule {
 	return selected
 }
 
+// checkRuleIDs reports rule IDs that do not name a known rule
+func checkRuleIDs(ids map[string]bool) error {
+	known := make(map[string]bool)
+	for _, rule := range rules.GetAllRules() {
+		known[rule.ID()] = true
+	}
+
+	var unknown []string
+	for id := range ids {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown rule ID(s): %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// configureRules passes command line settings to the rules that use them
+func configureRules(ruleList []rules.Rule, opts lintOptions) {
+	for _, rule := range ruleList {
+		switch r := rule.(type) {
+		case *rules.CSVSchemaRule:
+			r.Schema = opts.csvSchema
+		}
+	}
+}
+
+// checkPatterns rejects malformed manifest globs, which would otherwise
+// silently match nothing
+func checkPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// stringList collects the values of a repeatable flag
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
 // parseRuleList parses a comma-separated list of rule IDs
 func parseRuleList(list string) map[string]bool {
 	result := make(map[string]bool)
@@ -192,6 +807,17 @@ func hasErrors(violations []rules.Violation) bool {
 	return false
 }
 
+// countSeverity counts violations of the given severity
+func countSeverity(violations []rules.Violation, severity rules.Severity) int {
+	count := 0
+	for _, v := range violations {
+		if v.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
 // hasWarnings checks if there are any warning-level violations
 func hasWarnings(violations []rules.Violation) bool {
 	for _, v := range violations {
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// binary is the odhlint-bundle executable built for the tests that run
+// the CLI as a separate process
+var binary string
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "odhlint-bundle-test-")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	binary = filepath.Join(dir, "odhlint-bundle")
+	build := exec.Command("go", "build", "-o", binary, ".")
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to build odhlint-bundle: %v\n", err)
+		os.RemoveAll(dir)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// cliResult is the outcome of running the CLI
+type cliResult struct {
+	stdout string
+	stderr string
+	code   int
+}
+
+// runCLI runs odhlint-bundle with the given arguments from the package
+// directory, so testdata paths resolve
+func runCLI(t *testing.T, args ...string) cliResult {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(binary, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+
+	result := cliResult{stdout: stdout.String(), stderr: stderr.String()}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		result.code = exitErr.ExitCode()
+	} else if err != nil {
+		t.Fatalf("failed to run odhlint-bundle: %v", err)
+	}
+	return result
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/config"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/reporter"
+)
+
+// outputFile is an additional report destination requested with --output
+type outputFile struct {
+	reporter.Output
+	file *os.File
+
+	// document collects the results of every bundle when the format writes
+	// a single document (json, sarif or junit)
+	document *reporter.Document
+}
+
+// openOutputs parses the --output specs and creates their files. Files
+// already opened are closed again if a later spec fails.
+func openOutputs(specs []string) ([]outputFile, error) {
+	var outputs []outputFile
+	seen := make(map[string]bool)
+	for _, spec := range specs {
+		output, err := reporter.ParseOutput(spec)
+		if err == nil && seen[output.Path] {
+			err = fmt.Errorf("output %s is given more than once", output.Path)
+		}
+		if err != nil {
+			closeOutputs(outputs)
+			return nil, err
+		}
+		seen[output.Path] = true
+
+		file, err := os.Create(output.Path)
+		if err != nil {
+			closeOutputs(outputs)
+			return nil, fmt.Errorf("failed to create output: %w", err)
+		}
+		outputs = append(outputs, outputFile{Output: output, file: file, document: reporter.NewDocument()})
+	}
+	return outputs, nil
+}
+
+// closeOutputs closes the --output files, reporting any write error
+func closeOutputs(outputs []outputFile) {
+	for _, output := range outputs {
+		if err := output.file.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", output.Path, err)
+		}
+	}
+}
+
+// newReporter builds the reporter for one bundle: the primary format on
+// stdout plus every --output file. --quiet only applies to stdout; the
+// files always get the full report. json, sarif and junit reports are
+// collected per destination and written once by writeDocuments.
+func newReporter(bundlePath string, cfg *config.Config, opts lintOptions, skipped map[string]string) reporter.Multi {
+	newOne := func(writer io.Writer, format reporter.Format) *reporter.Reporter {
+		return reporter.NewWithFormat(writer, format).
+			WithPaths(opts.paths).
+			WithSeverityMap(cfg.SeverityMapFor(format)).
+			WithGroupBy(opts.groupBy).
+			WithStrict(opts.strict).
+			WithSkippedRules(skipped)
+	}
+
+	primary := newPrimaryReporter(newOne(opts.stdout, opts.format), opts).WithDocument(opts.document, bundlePath)
+	reporters := reporter.Multi{primary}
+	for _, output := range opts.outputs {
+		reporters = append(reporters, newOne(output.file, output.Format).WithDocument(output.document, bundlePath))
+	}
+	return reporters
+}
+
+// newPrimaryReporter applies the options that only concern the report on
+// stdout: the summary writer, --quiet and --no-color
+func newPrimaryReporter(rep *reporter.Reporter, opts lintOptions) *reporter.Reporter {
+	rep.WithSummaryWriter(opts.progress).WithQuiet(opts.quiet)
+	if opts.noColor {
+		rep.WithColor(false)
+	}
+	return rep
+}
+
+// writeDocuments writes the json, sarif or junit document collected from
+// every bundle to stdout and to each --output file, after the last bundle
+// was linted. It returns false if a document could not be written.
+func writeDocuments(opts lintOptions) bool {
+	ok := true
+	write := func(writer io.Writer, format reporter.Format, doc *reporter.Document) {
+		if !format.IsDocument() {
+			return
+		}
+		rep := reporter.NewWithFormat(writer, format).WithStrict(opts.strict)
+		if err := rep.WriteDocument(doc); err != nil {
+			fmt.Fprintf(os.Stderr, "Error reporting results: %v\n", err)
+			ok = false
+		}
+	}
+
+	write(opts.stdout, opts.format, opts.document)
+	for _, output := range opts.outputs {
+		write(output.file, output.Format, output.document)
+	}
+	return ok
+}
+
+// reportAggregate prints the totals of a run over several bundles after the
+// last bundle's report, on stdout only
+func reportAggregate(summary reporter.AggregateSummary, opts lintOptions) {
+	rep := newPrimaryReporter(reporter.NewWithFormat(opts.stdout, opts.format), opts).WithStrict(opts.strict)
+	if err := rep.ReportAggregate(summary); err != nil {
+		fmt.Fprintf(os.Stderr, "Error reporting summary: %v\n", err)
+	}
+}
+
+// heldOutput buffers report and progress output for --quiet-passing, so it
+// can be dropped when the run passes and printed when it fails
+type heldOutput struct {
+	stdout bytes.Buffer
+	stderr bytes.Buffer
+}
+
+// holdOutput redirects the report and progress writers in opts to buffers,
+// keeping progress on its original stream
+func holdOutput(opts *lintOptions) *heldOutput {
+	held := &heldOutput{}
+	if opts.progress == os.Stderr {
+		opts.progress = &held.stderr
+	} else {
+		opts.progress = &held.stdout
+	}
+	opts.stdout = &held.stdout
+	return held
+}
+
+// flush writes held output to the real streams. It is a no-op without
+// --quiet-passing.
+func (h *heldOutput) flush() {
+	if h == nil {
+		return
+	}
+	os.Stdout.Write(h.stdout.Bytes())
+	os.Stderr.Write(h.stderr.Bytes())
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/config"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/reporter"
+)
+
+// testOptions returns the options of a plain run in the given format, with
+// reports and progress discarded
+func testOptions(format reporter.Format) lintOptions {
+	return lintOptions{
+		format:      format,
+		stdout:      io.Discard,
+		progress:    io.Discard,
+		maxErrors:   -1,
+		maxWarnings: -1,
+		document:    reporter.NewDocument(),
+		groupBy:     reporter.GroupBySeverity,
+	}
+}
+
+func TestOutputFilesHoldOneDocument(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "report.json")
+	junitPath := filepath.Join(dir, "report.xml")
+
+	outputs, err := openOutputs([]string{"json=" + jsonPath, "junit=" + junitPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := testOptions(reporter.FormatText)
+	opts.outputs = outputs
+
+	for _, bundle := range []string{"testdata/bundles/clean", "testdata/bundles/mixed"} {
+		if _, ok := lintBundle(bundle, &config.Config{}, opts); !ok {
+			t.Fatalf("failed to lint %s", bundle)
+		}
+	}
+	if !writeDocuments(opts) {
+		t.Fatal("writeDocuments failed")
+	}
+	closeOutputs(outputs)
+
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	var report struct {
+		Bundles []struct {
+			Path string `json:"path"`
+		} `json:"bundles"`
+		Summary struct {
+			Errors int  `json:"errors"`
+			Passed bool `json:"passed"`
+		} `json:"summary"`
+	}
+	if err := decoder.Decode(&report); err != nil {
+		t.Fatalf("%s does not decode: %v", jsonPath, err)
+	}
+	if decoder.More() {
+		t.Fatalf("%s holds more than one JSON document:\n%s", jsonPath, data)
+	}
+	if len(report.Bundles) != 2 || report.Summary.Errors != 2 || report.Summary.Passed {
+		t.Errorf("unexpected report: %+v", report)
+	}
+
+	data, err = os.ReadFile(junitPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var suites struct {
+		Suites []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal(data, &suites); err != nil {
+		t.Fatalf("%s does not parse: %v", junitPath, err)
+	}
+	if n := bytes.Count(data, []byte("<testsuites")); n != 1 {
+		t.Errorf("%s holds %d <testsuites> elements, want 1", junitPath, n)
+	}
+	if len(suites.Suites) != 2 {
+		t.Errorf("got %d test suites, want one per bundle", len(suites.Suites))
+	}
+}
+
+func TestTextOutputNotColoredWhenPiped(t *testing.T) {
+	result := runCLI(t, "testdata/bundles/mixed")
+	if result.code != int(exitFindings) {
+		t.Fatalf("exit code = %d\nstderr:\n%s", result.code, result.stderr)
+	}
+	if strings.Contains(result.stdout, "\x1b[") {
+		t.Errorf("stdout is a pipe but contains ANSI escapes:\n%q", result.stdout)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestQuiet(t *testing.T) {
+	mixed, err := filepath.Abs("testdata/bundles/mixed/manifests/example-operator.clusterserviceversion.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	warning, err := filepath.Abs("testdata/bundles/warning/manifests/example-operator.clusterserviceversion.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		code ExitCode
+		want string
+	}{
+		{
+			name: "clean bundle",
+			args: []string{"testdata/bundles/clean"},
+			code: exitClean,
+			want: "",
+		},
+		{
+			// Warnings and info do not fail the run, so only errors print
+			name: "mixed bundle",
+			args: []string{"testdata/bundles/mixed"},
+			code: exitFindings,
+			want: "❌ [ODH-OLM-034] " + mixed + ":8: containerImage annotation 'quay.io/example/operator:latest' uses the latest tag\n" +
+				"❌ [ODH-OLM-034] " + mixed + ":47: Deployment 'example-operator' operator container 'manager' image 'quay.io/example/operator:latest' uses the latest tag\n",
+		},
+		{
+			name: "warnings only",
+			args: []string{"testdata/bundles/warning"},
+			code: exitClean,
+			want: "",
+		},
+		{
+			name: "strict warnings",
+			args: []string{"--strict", "testdata/bundles/warning"},
+			code: exitStrictWarnings,
+			want: "⚠️  [ODH-OLM-001] " + warning + ": ClusterServiceVersion is missing spec.minKubeVersion field\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := runCLI(t, append([]string{"--quiet"}, tt.args...)...)
+			if ExitCode(result.code) != tt.code {
+				t.Errorf("exit code = %d, want %d\nstderr:\n%s", result.code, tt.code, result.stderr)
+			}
+			if result.stderr != "" {
+				t.Errorf("stderr is not empty:\n%s", result.stderr)
+			}
+			if result.stdout != tt.want {
+				t.Errorf("stdout:\n%s\nwant:\n%s", result.stdout, tt.want)
+			}
+		})
+	}
+}
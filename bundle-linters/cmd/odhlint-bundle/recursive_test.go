@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/reporter"
+)
+
+// bundleTree lays out two bundles below a root directory, next to a
+// directory that is not a bundle, and returns the root
+func bundleTree(t *testing.T) string {
+	t.Helper()
+
+	root := t.TempDir()
+	copyBundleTo(t, "mixed", filepath.Join(root, "bundles", "prod", "operator"))
+	copyBundleTo(t, "warning", filepath.Join(root, "bundles", "dev", "operator"))
+	if err := os.MkdirAll(filepath.Join(root, "docs", "manifests"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func TestRecursiveAggregate(t *testing.T) {
+	root := bundleTree(t)
+
+	result := runCLI(t, "--recursive", root)
+	if result.code != int(exitFindings) {
+		t.Fatalf("exit code = %d, want %d\nstderr:\n%s", result.code, exitFindings, result.stderr)
+	}
+	want := `
+=== Summary: 2 bundle(s) ===
+  Passed:         1 (1 with warnings)
+  Failed:         1
+  Violations:     2 error(s), 2 warning(s), 2 info
+`
+	if !strings.HasSuffix(result.stdout, want) {
+		t.Errorf("stdout does not end with the aggregate summary %q:\n%s", want, result.stdout)
+	}
+}
+
+func TestRecursiveAggregateJSONL(t *testing.T) {
+	root := bundleTree(t)
+
+	result := runCLI(t, "--recursive", "--format", "jsonl", root)
+	if result.code != int(exitFindings) {
+		t.Fatalf("exit code = %d, want %d\nstderr:\n%s", result.code, exitFindings, result.stderr)
+	}
+	lines := strings.Split(strings.TrimSpace(result.stdout), "\n")
+	var aggregate struct {
+		Type string `json:"type"`
+		reporter.AggregateSummary
+	}
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &aggregate); err != nil {
+		t.Fatalf("last line is not json: %v\n%s", err, result.stdout)
+	}
+	want := reporter.AggregateSummary{Bundles: 2, Passed: 1, Failed: 1, WithWarnings: 1, Errors: 2, Warnings: 2, Info: 2}
+	if aggregate.Type != "aggregate" || aggregate.AggregateSummary != want {
+		t.Errorf("aggregate = %+v, want %+v", aggregate, want)
+	}
+}
+
+func TestRecursiveJSONHasBothBundles(t *testing.T) {
+	root := bundleTree(t)
+
+	result := runCLI(t, "--recursive", "--format", "json", root)
+	var report struct {
+		Bundles []struct {
+			Path    string `json:"path"`
+			Summary struct {
+				Total int `json:"total"`
+			} `json:"summary"`
+		} `json:"bundles"`
+		Summary struct {
+			Total  int  `json:"total"`
+			Errors int  `json:"errors"`
+			Passed bool `json:"passed"`
+		} `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(result.stdout), &report); err != nil {
+		t.Fatalf("stdout is not a json report: %v\n%s", err, result.stdout)
+	}
+
+	totals := make(map[string]int)
+	for _, bundle := range report.Bundles {
+		rel, err := filepath.Rel(root, bundle.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		totals[filepath.ToSlash(rel)] = bundle.Summary.Total
+	}
+	if len(totals) != 2 || totals["bundles/prod/operator"] != 5 || totals["bundles/dev/operator"] != 1 {
+		t.Errorf("per-bundle totals = %v", totals)
+	}
+	if report.Summary.Total != 6 || report.Summary.Errors != 2 || report.Summary.Passed {
+		t.Errorf("summary = %+v, want 6 violations with 2 errors, failed", report.Summary)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const mixedCSV = "testdata/bundles/mixed/manifests/example-operator.clusterserviceversion.yaml"
+
+func TestLintStandaloneCSV(t *testing.T) {
+	result := runCLI(t, "--format", "json", mixedCSV)
+	if result.code != int(exitFindings) {
+		t.Fatalf("exit code = %d, want %d\nstderr:\n%s", result.code, exitFindings, result.stderr)
+	}
+
+	var report struct {
+		Violations []struct {
+			RuleID string `json:"ruleId"`
+			File   string `json:"file"`
+		} `json:"violations"`
+		Summary struct {
+			Total    int `json:"total"`
+			Errors   int `json:"errors"`
+			Warnings int `json:"warnings"`
+		} `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(result.stdout), &report); err != nil {
+		t.Fatalf("stdout is not a json report: %v\n%s", err, result.stdout)
+	}
+	if report.Summary.Total != 5 || report.Summary.Errors != 2 || report.Summary.Warnings != 1 {
+		t.Errorf("summary = %+v, want the CSV's 5 violations (2 errors, 1 warning)", report.Summary)
+	}
+
+	want, err := filepath.Abs(mixedCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range report.Violations {
+		if v.File != want {
+			t.Errorf("%s reported in %q, want %q", v.RuleID, v.File, want)
+		}
+	}
+}
+
+func TestLintStandaloneCSVSkipsBundleRules(t *testing.T) {
+	result := runCLI(t, "--show-skipped", "testdata/bundles/clean/manifests/example-operator.clusterserviceversion.yaml")
+	if result.code != int(exitClean) {
+		t.Fatalf("exit code = %d, want %d\nstderr:\n%s", result.code, exitClean, result.stderr)
+	}
+	for _, want := range []string{
+		"ODH-OLM-040: a single manifest file was loaded, not a whole bundle",
+		"ODH-OLM-007: the bundle has no metadata/annotations.yaml",
+	} {
+		if !strings.Contains(result.stdout, want) {
+			t.Errorf("--show-skipped does not list %q:\n%s", want, result.stdout)
+		}
+	}
+}
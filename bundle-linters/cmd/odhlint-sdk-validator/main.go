@@ -0,0 +1,127 @@
+// Command odhlint-sdk-validator runs the bundle linter as an operator-sdk
+// external validator:
+//
+//	operator-sdk bundle validate ./bundle --alpha-select-external ./odhlint-sdk-validator
+//
+// operator-sdk invokes the validator with the bundle directory as its only
+// argument and decodes a ManifestResult from stdout. A non-zero exit status
+// means the validator itself failed, so findings are only ever reported
+// through the JSON result.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/config"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/lint"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/loader"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// configEnv names the environment variable holding config file paths,
+// separated like PATH, since operator-sdk passes no flags to validators
+const configEnv = "ODHLINT_CONFIG"
+
+// resultName identifies this validator's findings in operator-sdk output
+const resultName = "odh-linter"
+
+// manifestResult and validationError mirror ManifestResult and Error from
+// github.com/operator-framework/api/pkg/validation/errors, the format
+// operator-sdk reads from external validators
+type manifestResult struct {
+	Name     string            `json:"name,omitempty"`
+	Errors   []validationError `json:"errors"`
+	Warnings []validationError `json:"warnings"`
+}
+
+type validationError struct {
+	Type   string `json:"type,omitempty"`
+	Level  string `json:"level,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+const (
+	levelError = "Error"
+	levelWarn  = "Warning"
+
+	// errorInvalidBundle is operator-framework's ErrorInvalidBundle type
+	errorInvalidBundle = "BundleNotValid"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintf(os.Stderr, "Usage: %s <bundle-path>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Runs odhlint-bundle as an operator-sdk external validator:\n")
+		fmt.Fprintf(os.Stderr, "  operator-sdk bundle validate ./bundle --alpha-select-external %s\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Config files are read from $%s (separated by %q).\n", configEnv, string(os.PathListSeparator))
+		os.Exit(1)
+	}
+	bundlePath := os.Args[1]
+
+	cfg, err := config.LoadAll(filepath.SplitList(os.Getenv(configEnv)))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+	effective := cfg.Resolve(bundlePath)
+
+	ruleList := effective.SelectRules(rules.GetAllRules())
+	if err := effective.ConfigureRules(ruleList); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring rules: %v\n", err)
+		os.Exit(1)
+	}
+
+	result, err := lint.Lint(bundlePath, lint.Options{
+		Rules:  ruleList,
+		Load:   loader.Options{FileFilter: effective.ManifestFilter()},
+		Adjust: effective.ApplySeverities,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error linting bundle: %v\n", err)
+		os.Exit(1)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(toManifestResult(result.Violations)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing result: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// toManifestResult converts violations to operator-sdk's result format.
+// Errors fail `operator-sdk bundle validate`; warnings and info findings,
+// which the protocol has no level for, are reported as warnings.
+func toManifestResult(violations []rules.Violation) manifestResult {
+	result := manifestResult{
+		Name:     resultName,
+		Errors:   []validationError{},
+		Warnings: []validationError{},
+	}
+
+	for _, v := range violations {
+		detail := fmt.Sprintf("[%s] %s", v.RuleID, v.Message)
+		if v.File != "" {
+			detail = fmt.Sprintf("%s (%s)", detail, v.File)
+		}
+
+		if v.Severity == rules.SeverityError {
+			result.Errors = append(result.Errors, validationError{
+				Type:   errorInvalidBundle,
+				Level:  levelError,
+				Detail: detail,
+			})
+			continue
+		}
+		result.Warnings = append(result.Warnings, validationError{
+			Type:   errorInvalidBundle,
+			Level:  levelWarn,
+			Detail: detail,
+		})
+	}
+
+	return result
+}
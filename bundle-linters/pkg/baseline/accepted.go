@@ -0,0 +1,160 @@
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// Entry identifies an accepted violation by rule, file and message. Line
+// numbers and severities are left out so that edits elsewhere in a file or
+// severity overrides do not invalidate the baseline.
+type Entry struct {
+	RuleID  string `json:"ruleId"`
+	File    string `json:"file"`
+	Message string `json:"message"`
+}
+
+// acceptedFile is the on-disk form of a violations baseline
+type acceptedFile struct {
+	Comment    string  `json:"comment,omitempty"`
+	Violations []Entry `json:"violations"`
+}
+
+const acceptedComment = "Violations recorded by odhlint-bundle --write-baseline. Only violations not listed here fail the run."
+
+// Accepted is a set of violations recorded in a baseline file. Each entry
+// suppresses one matching violation, so a second identical violation is
+// still reported.
+type Accepted struct {
+	dir       string // Directory file paths are relative to
+	remaining map[Entry]int
+}
+
+// LoadAccepted reads a violations baseline file
+func LoadAccepted(path string) (*Accepted, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+
+	var file acceptedFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid baseline file %s: %w", path, err)
+	}
+
+	dir, err := baseDir(path)
+	if err != nil {
+		return nil, err
+	}
+	accepted := &Accepted{dir: dir, remaining: make(map[Entry]int)}
+	for i, entry := range file.Violations {
+		if entry.RuleID == "" {
+			return nil, fmt.Errorf("invalid baseline file %s: violations[%d]: ruleId is required", path, i)
+		}
+		entry.Message = normalizeMessage(entry.Message)
+		accepted.remaining[entry]++
+	}
+	return accepted, nil
+}
+
+// WriteAccepted records violations in a baseline file at path, replacing
+// any existing file. File paths are stored relative to the baseline's
+// directory so the file can be committed and used from any checkout.
+func WriteAccepted(path string, violations []rules.Violation) error {
+	dir, err := baseDir(path)
+	if err != nil {
+		return err
+	}
+
+	file := acceptedFile{Comment: acceptedComment, Violations: []Entry{}}
+	for _, v := range violations {
+		file.Violations = append(file.Violations, entryFor(dir, v))
+	}
+	sort.Slice(file.Violations, func(i, j int) bool {
+		a, b := file.Violations[i], file.Violations[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.RuleID != b.RuleID {
+			return a.RuleID < b.RuleID
+		}
+		return a.Message < b.Message
+	})
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Filter returns the violations not covered by the baseline and the number
+// that were suppressed. Matched entries are used up, so Filter can be
+// called once per bundle and Unmatched reports what was never seen.
+func (a *Accepted) Filter(violations []rules.Violation) ([]rules.Violation, int) {
+	var fresh []rules.Violation
+	suppressed := 0
+	for _, v := range violations {
+		entry := entryFor(a.dir, v)
+		if a.remaining[entry] > 0 {
+			a.remaining[entry]--
+			suppressed++
+			continue
+		}
+		fresh = append(fresh, v)
+	}
+	return fresh, suppressed
+}
+
+// Unmatched returns the number of baseline entries no violation matched,
+// typically because the violation was fixed
+func (a *Accepted) Unmatched() int {
+	total := 0
+	for _, count := range a.remaining {
+		total += count
+	}
+	return total
+}
+
+// entryFor builds the baseline key of a violation
+func entryFor(dir string, v rules.Violation) Entry {
+	return Entry{
+		RuleID:  v.RuleID,
+		File:    relativeFile(dir, v.File),
+		Message: normalizeMessage(v.Message),
+	}
+}
+
+// relativeFile expresses a violation's file relative to dir when it lies
+// below it. Other paths, such as files inside bundle images, are kept as
+// reported.
+func relativeFile(dir, file string) string {
+	if file == "" {
+		return ""
+	}
+	if abs, err := filepath.Abs(file); err == nil {
+		if rel, err := filepath.Rel(dir, abs); err == nil && rel != ".." && !strings.HasPrefix(rel, "../") {
+			return filepath.ToSlash(rel)
+		}
+	}
+	return filepath.ToSlash(file)
+}
+
+// normalizeMessage collapses whitespace so reformatted messages still match
+func normalizeMessage(message string) string {
+	return strings.Join(strings.Fields(message), " ")
+}
+
+func baseDir(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve baseline path: %w", err)
+	}
+	return filepath.Dir(abs), nil
+}
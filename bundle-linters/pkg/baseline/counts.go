@@ -0,0 +1,144 @@
+// Package baseline records the violations a project already has so that
+// gates can fail on regressions only.
+package baseline
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// Counts holds the number of violations per rule ID and severity
+type Counts map[string]map[rules.Severity]int
+
+// countsFile is the on-disk form of Counts
+type countsFile struct {
+	Counts Counts `yaml:"counts"`
+}
+
+const countsHeader = "# Violation counts recorded by odhlint-bundle --write-baseline-counts.\n" +
+	"# The run fails when a rule reports more violations of a severity than listed here.\n"
+
+// CountViolations tallies violations by rule and severity
+func CountViolations(violations []rules.Violation) Counts {
+	counts := make(Counts)
+	for _, v := range violations {
+		if counts[v.RuleID] == nil {
+			counts[v.RuleID] = make(map[rules.Severity]int)
+		}
+		counts[v.RuleID][v.Severity]++
+	}
+	return counts
+}
+
+// LoadCounts reads a counts baseline file
+func LoadCounts(path string) (Counts, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline counts: %w", err)
+	}
+
+	var file countsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid baseline counts file %s: %w", path, err)
+	}
+	for id, bySeverity := range file.Counts {
+		for severity, count := range bySeverity {
+			switch severity {
+			case rules.SeverityError, rules.SeverityWarning, rules.SeverityInfo:
+			default:
+				return nil, fmt.Errorf("invalid baseline counts file %s: rule %s: invalid severity %q", path, id, severity)
+			}
+			if count < 0 {
+				return nil, fmt.Errorf("invalid baseline counts file %s: rule %s: negative %s count", path, id, severity)
+			}
+		}
+	}
+
+	if file.Counts == nil {
+		file.Counts = make(Counts)
+	}
+	return file.Counts, nil
+}
+
+// Write saves the counts to path, replacing any existing file
+func (c Counts) Write(path string) error {
+	var buf bytes.Buffer
+	buf.WriteString(countsHeader)
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(countsFile{Counts: c}); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// Change describes a rule and severity whose count differs from the baseline
+type Change struct {
+	RuleID   string
+	Severity rules.Severity
+	Baseline int
+	Current  int
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s %s: %d (baseline %d)", c.RuleID, c.Severity, c.Current, c.Baseline)
+}
+
+// Compare checks current counts against the baseline. Regressions are
+// counts above the baseline, including rules and severities the baseline
+// does not list; improvements are counts below it. Both are sorted by rule
+// ID and severity.
+func (c Counts) Compare(current Counts) (regressions, improvements []Change) {
+	seen := make(map[string]map[rules.Severity]bool)
+	add := func(counts Counts) {
+		for id, bySeverity := range counts {
+			if seen[id] == nil {
+				seen[id] = make(map[rules.Severity]bool)
+			}
+			for severity := range bySeverity {
+				seen[id][severity] = true
+			}
+		}
+	}
+	add(c)
+	add(current)
+
+	for id, severities := range seen {
+		for severity := range severities {
+			change := Change{
+				RuleID:   id,
+				Severity: severity,
+				Baseline: c[id][severity],
+				Current:  current[id][severity],
+			}
+			switch {
+			case change.Current > change.Baseline:
+				regressions = append(regressions, change)
+			case change.Current < change.Baseline:
+				improvements = append(improvements, change)
+			}
+		}
+	}
+
+	sortChanges(regressions)
+	sortChanges(improvements)
+	return regressions, improvements
+}
+
+func sortChanges(changes []Change) {
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].RuleID != changes[j].RuleID {
+			return changes[i].RuleID < changes[j].RuleID
+		}
+		return changes[i].Severity < changes[j].Severity
+	})
+}
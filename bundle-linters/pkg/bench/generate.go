@@ -0,0 +1,232 @@
+// Package bench generates synthetic bundles of a given size for the
+// loader and rule benchmarks (go test -bench . ./pkg/rules/). Generated
+// bundles are deterministic, so results are comparable across machines and
+// releases.
+package bench
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Generate writes a synthetic bundle with the given number of manifest
+// files to dir. The bundle has one CSV; the other manifests cycle through
+// CRDs owned by the CSV, Services, ConfigMaps and PodDisruptionBudgets, so
+// both the typed and generic loader paths and most rules get exercised.
+// The CSV gets one deployment per 50 manifests and a conversion webhook
+// for every other CRD.
+func Generate(dir string, manifests int) error {
+	if manifests < 1 {
+		return fmt.Errorf("a bundle needs at least 1 manifest, got %d", manifests)
+	}
+
+	manifestsDir := filepath.Join(dir, "manifests")
+	metadataDir := filepath.Join(dir, "metadata")
+	for _, d := range []string{manifestsDir, metadataDir} {
+		if err := os.MkdirAll(d, 0o755); err != nil {
+			return err
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(metadataDir, "annotations.yaml"), []byte(annotations), 0o644); err != nil {
+		return err
+	}
+
+	var crds []int
+	for i := 1; i < manifests; i++ {
+		var name, content string
+		switch i % 4 {
+		case 0:
+			crds = append(crds, i)
+			name, content = fmt.Sprintf("crd%04d.yaml", i), fmt.Sprintf(crdTemplate, i, i, i, i, i)
+		case 1:
+			name, content = fmt.Sprintf("service%04d.yaml", i), fmt.Sprintf(serviceTemplate, i)
+		case 2:
+			name, content = fmt.Sprintf("configmap%04d.yaml", i), fmt.Sprintf(configMapTemplate, i, i)
+		case 3:
+			name, content = fmt.Sprintf("pdb%04d.yaml", i), fmt.Sprintf(pdbTemplate, i, i)
+		}
+		if err := os.WriteFile(filepath.Join(manifestsDir, name), []byte(content), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(filepath.Join(manifestsDir, "bench.clusterserviceversion.yaml"), []byte(csv(manifests, crds)), 0o644)
+}
+
+// csv renders the bundle's CSV for the given owned CRD indexes
+func csv(manifests int, crds []int) string {
+	var sb strings.Builder
+	sb.WriteString(csvHeader)
+
+	sb.WriteString("  customresourcedefinitions:\n    owned:\n")
+	for _, i := range crds {
+		fmt.Fprintf(&sb, "    - name: widget%04ds.bench.example.com\n      version: v1\n      kind: Widget%04d\n      displayName: Widget %d\n      description: Benchmark widget %d.\n", i, i, i, i)
+	}
+	if len(crds) == 0 {
+		sb.WriteString("    []\n")
+	}
+
+	sb.WriteString("  install:\n    strategy: deployment\n    spec:\n      deployments:\n")
+	deployments := 1 + manifests/50
+	for d := 0; d < deployments; d++ {
+		fmt.Fprintf(&sb, deploymentTemplate, d, d)
+	}
+
+	sb.WriteString("  webhookdefinitions:\n")
+	for n, i := range crds {
+		if n%2 == 1 {
+			continue
+		}
+		fmt.Fprintf(&sb, webhookTemplate, i, i, i)
+	}
+	if len(crds) == 0 {
+		sb.WriteString("  []\n")
+	}
+
+	return sb.String()
+}
+
+const annotations = `annotations:
+  operators.operatorframework.io.bundle.mediatype.v1: registry+v1
+  operators.operatorframework.io.bundle.manifests.v1: manifests/
+  operators.operatorframework.io.bundle.metadata.v1: metadata/
+  operators.operatorframework.io.bundle.package.v1: bench-operator
+  operators.operatorframework.io.bundle.channels.v1: stable
+  operators.operatorframework.io.bundle.channel.default.v1: stable
+`
+
+const csvHeader = `apiVersion: operators.coreos.com/v1alpha1
+kind: ClusterServiceVersion
+metadata:
+  name: bench-operator.v1.0.0
+  annotations:
+    containerImage: quay.io/bench/operator:v1.0.0
+spec:
+  version: 1.0.0
+  minKubeVersion: 1.25.0
+  installModes:
+  - type: OwnNamespace
+    supported: true
+  - type: SingleNamespace
+    supported: true
+  - type: MultiNamespace
+    supported: false
+  - type: AllNamespaces
+    supported: true
+  relatedImages:
+  - name: operator
+    image: quay.io/bench/operator:v1.0.0
+`
+
+const deploymentTemplate = `      - name: bench-operator-%d
+        spec:
+          replicas: 1
+          strategy:
+            type: RollingUpdate
+            rollingUpdate:
+              maxUnavailable: "0"
+              maxSurge: "1"
+          template:
+            spec:
+              containers:
+              - name: manager-%d
+                image: quay.io/bench/operator:v1.0.0
+                args:
+                - --leader-elect
+`
+
+const webhookTemplate = `  - type: ConversionWebhook
+    generateName: cwidget%04d.bench.example.com
+    deploymentName: bench-operator-0
+    containerPort: 443
+    targetPort: 9443
+    webhookPath: /convert-%04d
+    admissionReviewVersions: [v1]
+    sideEffects: None
+    timeoutSeconds: 10
+    conversionCRDs:
+    - widget%04ds.bench.example.com
+`
+
+const crdTemplate = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widget%04ds.bench.example.com
+spec:
+  group: bench.example.com
+  scope: Namespaced
+  names:
+    kind: Widget%04d
+    plural: widget%04ds
+    singular: widget%04d
+    categories: [bench]
+  versions:
+  - name: v1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          spec:
+            type: object
+            properties:
+              size:
+                type: integer
+              image:
+                type: string
+  - name: v1alpha1
+    served: true
+    storage: false
+    schema:
+      openAPIV3Schema:
+        type: object
+        x-kubernetes-preserve-unknown-fields: true
+  conversion:
+    strategy: Webhook
+    webhook:
+      conversionReviewVersions: [v1]
+      clientConfig:
+        service:
+          name: bench-webhook-service
+          namespace: system
+          path: /convert-%04d
+`
+
+const serviceTemplate = `apiVersion: v1
+kind: Service
+metadata:
+  name: bench-service-%d
+spec:
+  ports:
+  - name: metrics
+    port: 8443
+    targetPort: 8443
+  selector:
+    control-plane: controller-manager
+`
+
+const configMapTemplate = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: bench-config-%d
+data:
+  index: "%d"
+  settings.yaml: |
+    logLevel: info
+    reconcileInterval: 30s
+`
+
+const pdbTemplate = `apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: bench-pdb-%d
+spec:
+  minAvailable: 1
+  selector:
+    matchLabels:
+      app: bench-%d
+`
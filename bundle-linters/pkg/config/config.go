@@ -0,0 +1,384 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/reporter"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the config file discovered in a bundle directory when no
+// --config is given
+const FileName = ".odhlint.yaml"
+
+// Config holds linter settings loaded from a YAML config file
+type Config struct {
+	// Include restricts loading to manifest files matching at least one pattern
+	Include []string `yaml:"include"`
+
+	// Exclude skips manifest files matching any pattern
+	Exclude []string `yaml:"exclude"`
+
+	// RuleOverrides adjusts individual rules, keyed by rule ID
+	RuleOverrides map[string]RuleOverride `yaml:"rules"`
+
+	// Overrides apply additional settings to bundles whose path matches
+	Overrides []PathOverride `yaml:"overrides"`
+
+	// SeverityMap remaps reported severities per output format, e.g.
+	// {"json": {"warning": "error"}}. It only affects rendering.
+	SeverityMap map[string]map[string]string `yaml:"severityMap"`
+
+	// baseDir is the directory path-scoped patterns are resolved against
+	baseDir string
+}
+
+// RuleOverride changes whether a rule runs, at which severity it reports
+// and, for configurable rules, how it behaves
+type RuleOverride struct {
+	Enabled  *bool                  `yaml:"enabled"`
+	Severity string                 `yaml:"severity"`
+	Settings map[string]interface{} `yaml:"settings"`
+}
+
+// PathOverride applies settings to bundles matching any of Paths
+type PathOverride struct {
+	Paths         []string                `yaml:"paths"`
+	Include       []string                `yaml:"include"`
+	Exclude       []string                `yaml:"exclude"`
+	RuleOverrides map[string]RuleOverride `yaml:"rules"`
+
+	// baseDir is the directory of the config file that declared the override
+	baseDir string
+}
+
+// Load reads and validates a config file
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	// Unknown keys are rejected, so a misspelled key such as "exlude"
+	// fails loudly instead of being ignored. An empty file is a valid,
+	// empty config.
+	cfg := &Config{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve config path: %w", err)
+	}
+	cfg.baseDir = filepath.Dir(absPath)
+	for i := range cfg.Overrides {
+		cfg.Overrides[i].baseDir = cfg.baseDir
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// Discover returns the path of the config file in bundleDir, or "" if
+// there is none
+func Discover(bundleDir string) string {
+	path := filepath.Join(bundleDir, FileName)
+	if info, err := os.Stat(path); err == nil && !info.IsDir() {
+		return path
+	}
+	return ""
+}
+
+// LoadAll reads several config files and merges them in order, so later
+// files override earlier ones (see Merge)
+func LoadAll(paths []string) (*Config, error) {
+	merged := &Config{}
+	for i, path := range paths {
+		cfg, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			merged = cfg
+			continue
+		}
+		merged = Merge(merged, cfg)
+	}
+	return merged, nil
+}
+
+// Merge layers override on top of base and returns the result. Scalars
+// (enabled, severity, settings values) replace, maps (rules, settings)
+// merge recursively, a non-empty include list replaces the inherited one,
+// and exclude patterns and path-scoped overrides are appended. Path-scoped
+// overrides keep matching relative to the file that declared them.
+func Merge(base, override *Config) *Config {
+	merged := &Config{
+		Include:       append([]string(nil), base.Include...),
+		Exclude:       append(append([]string(nil), base.Exclude...), override.Exclude...),
+		RuleOverrides: make(map[string]RuleOverride),
+		Overrides:     append(append([]PathOverride(nil), base.Overrides...), override.Overrides...),
+		SeverityMap:   make(map[string]map[string]string),
+		baseDir:       base.baseDir,
+	}
+	for _, layer := range []*Config{base, override} {
+		for format, mapping := range layer.SeverityMap {
+			if merged.SeverityMap[format] == nil {
+				merged.SeverityMap[format] = make(map[string]string)
+			}
+			for from, to := range mapping {
+				merged.SeverityMap[format][from] = to
+			}
+		}
+	}
+	if len(override.Include) > 0 {
+		merged.Include = append([]string(nil), override.Include...)
+	}
+	if merged.baseDir == "" {
+		merged.baseDir = override.baseDir
+	}
+	mergeRuleOverrides(merged.RuleOverrides, base.RuleOverrides)
+	mergeRuleOverrides(merged.RuleOverrides, override.RuleOverrides)
+	return merged
+}
+
+// validate checks rule IDs, severities and settings referenced by the config
+func (c *Config) validate() error {
+	if err := validateRuleOverrides(c.RuleOverrides); err != nil {
+		return err
+	}
+
+	for i, override := range c.Overrides {
+		if len(override.Paths) == 0 {
+			return fmt.Errorf("overrides[%d]: at least one path pattern is required", i)
+		}
+		if err := validateRuleOverrides(override.RuleOverrides); err != nil {
+			return fmt.Errorf("overrides[%d]: %w", i, err)
+		}
+	}
+
+	for name, mapping := range c.SeverityMap {
+		format, err := reporter.ParseFormat(name)
+		if err != nil {
+			return fmt.Errorf("severityMap: %w", err)
+		}
+		if _, err := reporter.ParseSeverityMap(format, mapping); err != nil {
+			return fmt.Errorf("severityMap.%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateRuleOverrides(overrides map[string]RuleOverride) error {
+	for id, override := range overrides {
+		if rules.GetRuleByID(id) == nil {
+			return fmt.Errorf("unknown rule ID %q", id)
+		}
+		if override.Severity != "" && !isValidSeverity(override.Severity) {
+			return fmt.Errorf("rule %s: invalid severity %q (expected error, warning or info)", id, override.Severity)
+		}
+		if len(override.Settings) > 0 {
+			configurable, ok := rules.GetRuleByID(id).(rules.Configurable)
+			if !ok {
+				return fmt.Errorf("rule %s does not accept settings", id)
+			}
+			if err := configurable.Configure(override.Settings); err != nil {
+				return fmt.Errorf("rule %s: %w", id, err)
+			}
+		}
+	}
+	return nil
+}
+
+func isValidSeverity(severity string) bool {
+	switch rules.Severity(severity) {
+	case rules.SeverityError, rules.SeverityWarning, rules.SeverityInfo:
+		return true
+	}
+	return false
+}
+
+// Resolve returns the effective config for a bundle. Path-scoped overrides
+// whose patterns match the bundle path are layered over the top-level
+// settings in the order they appear in the file, so later entries win.
+func (c *Config) Resolve(bundlePath string) *Config {
+	effective := &Config{
+		Include:       append([]string(nil), c.Include...),
+		Exclude:       append([]string(nil), c.Exclude...),
+		RuleOverrides: make(map[string]RuleOverride),
+		baseDir:       c.baseDir,
+	}
+	mergeRuleOverrides(effective.RuleOverrides, c.RuleOverrides)
+
+	for _, override := range c.Overrides {
+		baseDir := override.baseDir
+		if baseDir == "" {
+			baseDir = c.baseDir
+		}
+		if !matchAny(override.Paths, relativePath(baseDir, bundlePath)) {
+			continue
+		}
+		if len(override.Include) > 0 {
+			effective.Include = append([]string(nil), override.Include...)
+		}
+		effective.Exclude = append(effective.Exclude, override.Exclude...)
+		mergeRuleOverrides(effective.RuleOverrides, override.RuleOverrides)
+	}
+
+	return effective
+}
+
+// relativePath expresses a bundle path relative to a config file's
+// directory, falling back to the cleaned absolute path when the bundle
+// lives outside of it.
+func relativePath(baseDir, bundlePath string) string {
+	absPath, err := filepath.Abs(bundlePath)
+	if err != nil {
+		return filepath.ToSlash(filepath.Clean(bundlePath))
+	}
+
+	if baseDir == "" {
+		baseDir, _ = os.Getwd()
+	}
+
+	if rel, err := filepath.Rel(baseDir, absPath); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	return filepath.ToSlash(absPath)
+}
+
+// mergeRuleOverrides copies src into dst, field by field. Settings are
+// merged recursively so nested maps combine instead of replacing each other.
+func mergeRuleOverrides(dst, src map[string]RuleOverride) {
+	for id, override := range src {
+		merged := dst[id]
+		if override.Enabled != nil {
+			enabled := *override.Enabled
+			merged.Enabled = &enabled
+		}
+		if override.Severity != "" {
+			merged.Severity = override.Severity
+		}
+		if len(override.Settings) > 0 {
+			merged.Settings = mergeSettings(merged.Settings, override.Settings)
+		}
+		dst[id] = merged
+	}
+}
+
+// mergeSettings returns a copy of dst with src merged in. Nested maps are
+// merged key by key; any other value in src replaces the one in dst.
+func mergeSettings(dst, src map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(dst)+len(src))
+	for key, value := range dst {
+		merged[key] = value
+	}
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := merged[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			merged[key] = mergeSettings(dstMap, srcMap)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+// SeverityMapFor returns the severity mapping for an output format, or
+// nil when the config does not remap it
+func (c *Config) SeverityMapFor(format reporter.Format) reporter.SeverityMap {
+	mapping, ok := c.SeverityMap[string(format)]
+	if !ok {
+		return nil
+	}
+	// Validated when the config was loaded
+	parsed, _ := reporter.ParseSeverityMap(format, mapping)
+	return parsed
+}
+
+// SelectRules drops rules disabled by the config
+func (c *Config) SelectRules(ruleList []rules.Rule) []rules.Rule {
+	var selected []rules.Rule
+	for _, rule := range ruleList {
+		if override, ok := c.RuleOverrides[rule.ID()]; ok && override.Enabled != nil && !*override.Enabled {
+			continue
+		}
+		selected = append(selected, rule)
+	}
+	return selected
+}
+
+// ConfigureRules passes per-rule settings to configurable rules
+func (c *Config) ConfigureRules(ruleList []rules.Rule) error {
+	for _, rule := range ruleList {
+		override, ok := c.RuleOverrides[rule.ID()]
+		if !ok || len(override.Settings) == 0 {
+			continue
+		}
+		if configurable, ok := rule.(rules.Configurable); ok {
+			if err := configurable.Configure(override.Settings); err != nil {
+				return fmt.Errorf("rule %s: %w", rule.ID(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// ApplySeverities rewrites violation severities according to the config
+func (c *Config) ApplySeverities(violations []rules.Violation) {
+	for i := range violations {
+		if override, ok := c.RuleOverrides[violations[i].RuleID]; ok && override.Severity != "" {
+			violations[i].Severity = rules.Severity(override.Severity)
+		}
+	}
+}
+
+// Demote forces the given rules to info severity, taking precedence over
+// any severity set in the config files. Demoted rules still run and their
+// findings are still reported; they just never fail the run.
+func (c *Config) Demote(ids map[string]bool) {
+	for id := range ids {
+		override := c.RuleOverrides[id]
+		override.Severity = string(rules.SeverityInfo)
+		c.RuleOverrides[id] = override
+	}
+}
+
+// FilterManifests applies manifest patterns given on the command line. A
+// non-empty include replaces the configured list, like an override entry;
+// exclude patterns are added to the configured ones.
+func (c *Config) FilterManifests(include, exclude []string) {
+	if len(include) > 0 {
+		c.Include = append([]string(nil), include...)
+	}
+	c.Exclude = append(c.Exclude, exclude...)
+}
+
+// ManifestFilter reports whether a manifest file, given relative to the
+// manifests directory, should be loaded
+func (c *Config) ManifestFilter() func(name string) bool {
+	include := c.Include
+	exclude := c.Exclude
+	return func(name string) bool {
+		name = filepath.ToSlash(name)
+		if len(include) > 0 && !matchAny(include, name) {
+			return false
+		}
+		return !matchAny(exclude, name)
+	}
+}
@@ -0,0 +1,325 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeConfig writes a config file to a temporary directory and returns
+// its path
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), FileName)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadRejectsUnknownKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "misspelled top-level key",
+			content: "exlude: [\"*.sample.yaml\"]\n",
+			wantErr: "field exlude not found",
+		},
+		{
+			name: "misspelled rule override key",
+			content: `rules:
+  ODH-OLM-001:
+    severty: error
+`,
+			wantErr: "field severty not found",
+		},
+		{
+			name: "misspelled path override key",
+			content: `overrides:
+- path: ["bundles/prod/**"]
+`,
+			wantErr: "field path not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeConfig(t, tt.content))
+			if err == nil {
+				t.Fatal("Load() succeeded, want an error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %q, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	cfg, err := Load(writeConfig(t, `include: ["*.yaml"]
+exclude: ["*.sample.yaml"]
+rules:
+  ODH-OLM-001:
+    severity: error
+overrides:
+- paths: ["bundles/prod/**"]
+  rules:
+    ODH-OLM-001:
+      enabled: false
+severityMap:
+  json:
+    warning: error
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Include) != 1 || len(cfg.Exclude) != 1 || cfg.RuleOverrides["ODH-OLM-001"].Severity != "error" {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+	if len(cfg.Overrides) != 1 || cfg.SeverityMap["json"]["warning"] != "error" {
+		t.Errorf("unexpected overrides or severity map: %+v", cfg)
+	}
+}
+
+func TestLoadEmpty(t *testing.T) {
+	cfg, err := Load(writeConfig(t, "# nothing configured yet\n"))
+	if err != nil {
+		t.Fatalf("Load() of an empty file = %v, want nil", err)
+	}
+	if len(cfg.RuleOverrides) != 0 || len(cfg.Include) != 0 {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+}
+
+// writeConfigIn writes a config file to dir and returns its path
+func writeConfigIn(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadAllMergesInOrder(t *testing.T) {
+	dir := t.TempDir()
+	base := writeConfigIn(t, dir, "base.yaml", `include: ["*.yaml"]
+exclude: ["*.sample.yaml"]
+rules:
+  ODH-OLM-001:
+    severity: error
+  ODH-OLM-015:
+    settings:
+      monitoringKinds: [ServiceMonitor]
+      metricsArgs: ["--metrics-bind-address"]
+  ODH-OLM-022:
+    enabled: false
+severityMap:
+  json:
+    warning: error
+  text:
+    info: warning
+`)
+	override := writeConfigIn(t, dir, "override.yaml", `exclude: ["*.test.yaml"]
+rules:
+  ODH-OLM-001:
+    enabled: false
+  ODH-OLM-015:
+    settings:
+      metricsArgs: ["--metrics-addr"]
+  ODH-OLM-022:
+    enabled: true
+severityMap:
+  json:
+    warning: info
+`)
+
+	cfg, err := LoadAll([]string{base, override})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An empty include list inherits, exclude patterns accumulate
+	if !reflect.DeepEqual(cfg.Include, []string{"*.yaml"}) {
+		t.Errorf("include = %q, want the base list", cfg.Include)
+	}
+	if !reflect.DeepEqual(cfg.Exclude, []string{"*.sample.yaml", "*.test.yaml"}) {
+		t.Errorf("exclude = %q, want both files' patterns", cfg.Exclude)
+	}
+
+	// Rule overrides merge field by field, the later file winning
+	rule := cfg.RuleOverrides["ODH-OLM-001"]
+	if rule.Enabled == nil || *rule.Enabled || rule.Severity != "error" {
+		t.Errorf("ODH-OLM-001 = %+v, want disabled with the base severity", rule)
+	}
+	if rule := cfg.RuleOverrides["ODH-OLM-022"]; rule.Enabled == nil || !*rule.Enabled {
+		t.Errorf("ODH-OLM-022 = %+v, want re-enabled by the later file", rule)
+	}
+	wantSettings := map[string]interface{}{
+		"monitoringKinds": []interface{}{"ServiceMonitor"},
+		"metricsArgs":     []interface{}{"--metrics-addr"},
+	}
+	if settings := cfg.RuleOverrides["ODH-OLM-015"].Settings; !reflect.DeepEqual(settings, wantSettings) {
+		t.Errorf("ODH-OLM-015 settings = %v, want %v", settings, wantSettings)
+	}
+
+	// Severity maps merge per format and per severity
+	wantMap := map[string]map[string]string{
+		"json": {"warning": "info"},
+		"text": {"info": "warning"},
+	}
+	if !reflect.DeepEqual(cfg.SeverityMap, wantMap) {
+		t.Errorf("severity map = %v, want %v", cfg.SeverityMap, wantMap)
+	}
+}
+
+func TestLoadAllIncludeReplaces(t *testing.T) {
+	dir := t.TempDir()
+	cfg, err := LoadAll([]string{
+		writeConfigIn(t, dir, "base.yaml", `include: ["*.yaml"]`),
+		writeConfigIn(t, dir, "override.yaml", `include: ["*.crd.yaml", "*.clusterserviceversion.yaml"]`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(cfg.Include, []string{"*.crd.yaml", "*.clusterserviceversion.yaml"}) {
+		t.Errorf("include = %q, want the later file's list", cfg.Include)
+	}
+}
+
+func TestLoadAllPathOverridesKeepTheirDirectory(t *testing.T) {
+	dir := t.TempDir()
+	base := writeConfigIn(t, dir, "base.yaml", `overrides:
+- paths: ["bundles/prod/**"]
+  rules:
+    ODH-OLM-001:
+      severity: error
+`)
+	// Declared in another directory, so it matches bundles below that one
+	team := writeConfigIn(t, dir, "team/override.yaml", `overrides:
+- paths: ["bundles/prod/**"]
+  rules:
+    ODH-OLM-001:
+      severity: info
+`)
+
+	cfg, err := LoadAll([]string{base, team})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Overrides) != 2 {
+		t.Fatalf("got %d path overrides, want both files' entries", len(cfg.Overrides))
+	}
+
+	tests := []struct {
+		bundle string
+		want   string
+	}{
+		{filepath.Join(dir, "bundles", "prod", "operator"), "error"},
+		{filepath.Join(dir, "team", "bundles", "prod", "operator"), "info"},
+		{filepath.Join(dir, "bundles", "dev", "operator"), ""},
+	}
+	for _, tt := range tests {
+		got := cfg.Resolve(tt.bundle).RuleOverrides["ODH-OLM-001"].Severity
+		if got != tt.want {
+			t.Errorf("severity for %s = %q, want %q", tt.bundle, got, tt.want)
+		}
+	}
+}
+
+func TestMergeSettingsNested(t *testing.T) {
+	got := mergeSettings(
+		map[string]interface{}{
+			"registries": map[string]interface{}{"quay.io": true, "docker.io": false},
+			"limit":      1,
+		},
+		map[string]interface{}{
+			"registries": map[string]interface{}{"docker.io": true},
+			"limit":      2,
+		},
+	)
+	want := map[string]interface{}{
+		"registries": map[string]interface{}{"quay.io": true, "docker.io": true},
+		"limit":      2,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeSettings() = %v, want %v", got, want)
+	}
+}
+
+func TestManifestFilter(t *testing.T) {
+	tests := []struct {
+		name             string
+		include, exclude []string // From the config file
+		flagInclude      []string
+		flagExclude      []string
+		loaded           []string
+		skipped          []string
+	}{
+		{
+			name:    "no patterns",
+			loaded:  []string{"widgets.crd.yaml", "example.clusterserviceversion.yaml"},
+			skipped: nil,
+		},
+		{
+			name:        "only CRDs",
+			flagInclude: []string{"*.crd.yaml"},
+			loaded:      []string{"widgets.crd.yaml"},
+			skipped:     []string{"example.clusterserviceversion.yaml"},
+		},
+		{
+			name:        "flag include replaces the config's",
+			include:     []string{"*.clusterserviceversion.yaml"},
+			flagInclude: []string{"**/*.crd.yaml"},
+			loaded:      []string{"widgets.crd.yaml"},
+			skipped:     []string{"example.clusterserviceversion.yaml"},
+		},
+		{
+			name:        "exclude wins over include",
+			include:     []string{"*.yaml"},
+			flagExclude: []string{"*.sample.yaml"},
+			loaded:      []string{"widgets.crd.yaml"},
+			skipped:     []string{"widgets.sample.yaml"},
+		},
+		{
+			name:        "flag exclude adds to the config's",
+			exclude:     []string{"*.sample.yaml"},
+			flagExclude: []string{"*.crd.yaml"},
+			loaded:      []string{"example.clusterserviceversion.yaml"},
+			skipped:     []string{"widgets.sample.yaml", "widgets.crd.yaml"},
+		},
+		{
+			name:        "excluding an included file",
+			flagInclude: []string{"widgets.*"},
+			flagExclude: []string{"widgets.crd.yaml"},
+			loaded:      []string{"widgets.sample.yaml"},
+			skipped:     []string{"widgets.crd.yaml"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Include: tt.include, Exclude: tt.exclude}
+			cfg.FilterManifests(tt.flagInclude, tt.flagExclude)
+			filter := cfg.ManifestFilter()
+			for _, name := range tt.loaded {
+				if !filter(name) {
+					t.Errorf("%s is skipped, want it loaded", name)
+				}
+			}
+			for _, name := range tt.skipped {
+				if filter(name) {
+					t.Errorf("%s is loaded, want it skipped", name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"path"
+	"strings"
+)
+
+// MatchPath reports whether a slash-separated path matches a glob pattern.
+// Patterns use path.Match syntax for each segment, plus "**" which matches
+// zero or more whole segments (e.g. "bundles/prod/**").
+func MatchPath(pattern, name string) bool {
+	pattern = strings.Trim(pattern, "/")
+	name = strings.Trim(name, "/")
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// Collapse consecutive "**" and try every possible split point
+			rest := pattern[1:]
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(rest, name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+		if ok, err := path.Match(pattern[0], name[0]); err != nil || !ok {
+			return false
+		}
+		pattern = pattern[1:]
+		name = name[1:]
+	}
+
+	return len(name) == 0
+}
+
+// matchAny reports whether name matches at least one of the patterns
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if MatchPath(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package config
+
+import "testing"
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"*.crd.yaml", "widgets.crd.yaml", true},
+		{"*.crd.yaml", "example.clusterserviceversion.yaml", false},
+		{"*.yaml", "samples/widget.yaml", false},
+		{"samples/*", "samples/widget.yaml", true},
+		{"widget-?.yaml", "widget-a.yaml", true},
+		{"widget-[ab].yaml", "widget-c.yaml", false},
+		{"**", "bundles/prod/operator", true},
+		{"bundles/prod/**", "bundles/prod", true},
+		{"bundles/prod/**", "bundles/prod/operator/v1", true},
+		{"bundles/prod/**", "bundles/production", false},
+		{"bundles/**/operator", "bundles/operator", true},
+		{"bundles/**/operator", "bundles/prod/eu/operator", true},
+		{"bundles/**/operator", "bundles/prod/operator/v1", false},
+		{"**/*.crd.yaml", "widgets.crd.yaml", true},
+		{"**/**/*.crd.yaml", "crds/widgets.crd.yaml", true},
+		{"/bundles/prod/", "bundles/prod", true},
+		{"bundles/prod", "/bundles/prod/", true},
+		{"[", "[", false},
+	}
+
+	for _, tt := range tests {
+		if got := MatchPath(tt.pattern, tt.name); got != tt.want {
+			t.Errorf("MatchPath(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
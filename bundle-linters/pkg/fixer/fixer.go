@@ -0,0 +1,234 @@
+package fixer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+	"gopkg.in/yaml.v3"
+)
+
+// Summary compares the violations found before and after fixing
+type Summary struct {
+	Fixed      int // Violations that no longer occur
+	Remaining  int // Violations that still occur
+	NotFixable int // Remaining violations whose rule cannot fix them
+
+	Resolved []rules.Violation // The fixed violations, as reported before fixing
+}
+
+// Apply fixes violations in place by editing the files they were reported
+// in. Violations whose rule does not implement rules.Fixer are skipped. It
+// returns the files that were rewritten.
+//
+// Fixes that only change scalar values are patched into the original text.
+// Fixes that add or remove nodes re-encode the file, which keeps comments
+// and key order but normalizes indentation to two spaces.
+func Apply(violations []rules.Violation) ([]string, error) {
+	var files []string
+	byFile := make(map[string][]rules.Violation)
+	for _, v := range violations {
+		if !v.Fixable || v.File == "" {
+			continue
+		}
+		if _, ok := rules.GetRuleByID(v.RuleID).(rules.Fixer); !ok {
+			continue
+		}
+		if _, seen := byFile[v.File]; !seen {
+			files = append(files, v.File)
+		}
+		byFile[v.File] = append(byFile[v.File], v)
+	}
+
+	var written []string
+	for _, file := range files {
+		changed, err := fixFile(file, byFile[file])
+		if err != nil {
+			return written, fmt.Errorf("failed to fix %s: %w", file, err)
+		}
+		if changed {
+			written = append(written, file)
+		}
+	}
+
+	return written, nil
+}
+
+// fixFile applies the fixes for one file, writing it back if any changed
+func fixFile(path string, violations []rules.Violation) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	var docs []*yaml.Node
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		doc := &yaml.Node{}
+		if err := decoder.Decode(doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return false, err
+		}
+		docs = append(docs, doc)
+	}
+
+	before := snapshotScalars(docs)
+
+	changed := false
+	for _, v := range violations {
+		fixer := rules.GetRuleByID(v.RuleID).(rules.Fixer)
+		for _, doc := range docs {
+			ok, err := fixer.Fix(doc, v)
+			if err != nil {
+				return false, fmt.Errorf("%s: %w", v.RuleID, err)
+			}
+			changed = changed || ok
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+
+	// Prefer patching changed scalars in the original text so the rest of
+	// the file keeps its exact formatting
+	output, ok := patchScalars(data, before, snapshotScalars(docs))
+	if !ok {
+		if output, err = encodeDocuments(docs); err != nil {
+			return false, err
+		}
+	}
+
+	if err := os.WriteFile(path, output, info.Mode().Perm()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// encodeDocuments re-encodes all documents of a file
+func encodeDocuments(docs []*yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	for _, doc := range docs {
+		if err := encoder.Encode(doc); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// scalarState records a node's value and source position before fixing
+type scalarState struct {
+	node   *yaml.Node
+	value  string
+	line   int
+	column int
+}
+
+// snapshotScalars lists every node in the documents, recording the value
+// and position of scalars
+func snapshotScalars(docs []*yaml.Node) []scalarState {
+	var states []scalarState
+	var walk func(node *yaml.Node)
+	walk = func(node *yaml.Node) {
+		states = append(states, scalarState{node: node, value: node.Value, line: node.Line, column: node.Column})
+		for _, child := range node.Content {
+			walk(child)
+		}
+	}
+	for _, doc := range docs {
+		walk(doc)
+	}
+	return states
+}
+
+// patchScalars rewrites changed plain scalars in the original text. It
+// reports false when the fix changed the document structure or touched a
+// scalar it cannot locate, in which case the documents must be re-encoded.
+func patchScalars(data []byte, before, after []scalarState) ([]byte, bool) {
+	if len(before) != len(after) {
+		return nil, false
+	}
+
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	var edits []scalarState
+	for i, state := range before {
+		if after[i].node != state.node {
+			return nil, false
+		}
+		if state.node.Value == state.value {
+			continue
+		}
+		if state.node.Kind != yaml.ScalarNode || state.line < 1 || state.line > len(lines) {
+			return nil, false
+		}
+		line := lines[state.line-1]
+		start := state.column - 1
+		if start < 0 || !bytes.HasPrefix(line[start:], []byte(state.value)) {
+			return nil, false
+		}
+		edits = append(edits, state)
+	}
+
+	// Apply edits right to left so earlier columns stay valid
+	sort.Slice(edits, func(i, j int) bool {
+		if edits[i].line != edits[j].line {
+			return edits[i].line < edits[j].line
+		}
+		return edits[i].column > edits[j].column
+	})
+	for _, edit := range edits {
+		line := lines[edit.line-1]
+		start := edit.column - 1
+		patched := append([]byte(nil), line[:start]...)
+		patched = append(patched, edit.node.Value...)
+		patched = append(patched, line[start+len(edit.value):]...)
+		lines[edit.line-1] = patched
+	}
+
+	return bytes.Join(lines, nil), true
+}
+
+// Summarize compares violations from before and after fixing. Violations
+// are matched by rule, file and message.
+func Summarize(before, after []rules.Violation) Summary {
+	remaining := make(map[string]int)
+	for _, v := range after {
+		remaining[violationKey(v)]++
+	}
+
+	summary := Summary{Remaining: len(after)}
+	for _, v := range before {
+		key := violationKey(v)
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
+		}
+		summary.Fixed++
+		summary.Resolved = append(summary.Resolved, v)
+	}
+	for _, v := range after {
+		if !v.Fixable {
+			summary.NotFixable++
+		}
+	}
+
+	return summary
+}
+
+func violationKey(v rules.Violation) string {
+	return v.RuleID + "\x00" + v.File + "\x00" + v.Message
+}
@@ -0,0 +1,98 @@
+package fixer
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+var update = flag.Bool("update", false, "rewrite the .golden.yaml files with the current output")
+
+// TestApplyGolden fixes each testdata file in a temporary copy and compares
+// the result with its .golden.yaml file
+func TestApplyGolden(t *testing.T) {
+	tests := []struct {
+		file   string
+		ruleID string
+	}{
+		// Scalar fixes are patched into the text, keeping its formatting
+		{"priorityclass.yaml", "ODH-OLM-006"},
+		{"crds.yaml", "ODH-OLM-056"},
+		{"csv.yaml", "ODH-OLM-003"},
+		// Structural fixes re-encode the file, keeping comments and key
+		// order but normalizing indentation
+		{"csv-missing-mode.yaml", "ODH-OLM-003"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			input, err := os.ReadFile(filepath.Join("testdata", tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, input, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			written, err := Apply([]rules.Violation{{RuleID: tt.ruleID, Fixable: true, File: path}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(written) != 1 || written[0] != path {
+				t.Fatalf("Apply() wrote %q, want %q", written, path)
+			}
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			golden := filepath.Join("testdata", strings.TrimSuffix(tt.file, ".yaml")+".golden.yaml")
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("fixed %s:\n%s\nwant:\n%s", tt.file, got, want)
+			}
+		})
+	}
+}
+
+func TestApplySkipsUnfixable(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("testdata", "priorityclass.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "priorityclass.yaml")
+	if err := os.WriteFile(path, input, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	written, err := Apply([]rules.Violation{
+		{RuleID: "ODH-OLM-006", Fixable: false, File: path},
+		{RuleID: "ODH-OLM-001", Fixable: true, File: path},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(written) != 0 {
+		t.Errorf("Apply() wrote %q, want nothing", written)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(input) {
+		t.Errorf("file changed without a fixable violation:\n%s", got)
+	}
+}
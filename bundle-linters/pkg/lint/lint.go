@@ -0,0 +1,163 @@
+// Package lint runs the bundle linter as a library. Run reports progress
+// through events so that embedding applications, such as a web service
+// that lints uploaded bundles, can render live progress without scraping
+// command line output.
+package lint
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/loader"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// EventType identifies a lint event
+type EventType string
+
+const (
+	// EventLoadStarted is emitted before the bundle is loaded
+	EventLoadStarted EventType = "LoadStarted"
+	// EventFileLoaded is emitted for each file parsed into the bundle
+	EventFileLoaded EventType = "FileLoaded"
+	// EventRuleEvaluated is emitted after each rule has run
+	EventRuleEvaluated EventType = "RuleEvaluated"
+	// EventViolationFound is emitted for each violation, before the
+	// RuleEvaluated event of the rule that reported it
+	EventViolationFound EventType = "ViolationFound"
+	// EventDone is always the last event, on success and on failure
+	EventDone EventType = "Done"
+)
+
+// Event describes progress while a bundle is linted. Only the fields
+// relevant to the event type are set.
+type Event struct {
+	Type       EventType        `json:"type"`
+	Time       time.Time        `json:"time"`
+	BundlePath string           `json:"bundlePath"`
+	File       string           `json:"file,omitempty"`      // FileLoaded
+	RuleID     string           `json:"ruleId,omitempty"`    // RuleEvaluated
+	Count      int              `json:"count"`               // RuleEvaluated: violations reported by the rule
+	Violation  *rules.Violation `json:"violation,omitempty"` // ViolationFound
+	Result     *Result          `json:"result,omitempty"`    // Done
+	Error      string           `json:"error,omitempty"`     // Done, when linting failed
+}
+
+// Options controls a lint run
+type Options struct {
+	// Rules to run; nil runs every registered rule
+	Rules []rules.Rule
+
+	// Load is passed to the bundle loader. Its OnFileLoaded callback is
+	// still called in addition to the FileLoaded event.
+	Load loader.Options
+
+	// FailFast and Adjust are passed to rules.ValidateOptions
+	FailFast bool
+	Adjust   func(violations []rules.Violation)
+
+	// Logger is passed to rules.ValidateOptions. Set Load.Logger as well to
+	// log the files loaded.
+	Logger *slog.Logger
+}
+
+// Result is the outcome of a lint run
+type Result struct {
+	Violations []rules.Violation `json:"violations"`
+	StoppedBy  string            `json:"stoppedBy,omitempty"` // Rule that triggered FailFast
+	Skipped    int               `json:"skipped,omitempty"`   // Rules not run
+	Duration   time.Duration     `json:"duration"`
+
+	// NotApplicable lists rules that ran but do not apply to the bundle
+	NotApplicable []rules.SkippedRule `json:"notApplicable,omitempty"`
+}
+
+// Run lints the bundle at bundlePath, calling emit for every event. emit
+// is called synchronously from the calling goroutine; a nil emit discards
+// events. Cancelling ctx stops validation between rules.
+func Run(ctx context.Context, bundlePath string, opts Options, emit func(Event)) (*Result, error) {
+	start := time.Now()
+	send := func(event Event) {
+		if emit == nil {
+			return
+		}
+		event.Time = time.Now()
+		event.BundlePath = bundlePath
+		emit(event)
+	}
+	done := func(result *Result, err error) (*Result, error) {
+		result.Duration = time.Since(start)
+		event := Event{Type: EventDone, Result: result}
+		if err != nil {
+			event.Error = err.Error()
+		}
+		send(event)
+		return result, err
+	}
+
+	send(Event{Type: EventLoadStarted})
+
+	loadOpts := opts.Load
+	onFileLoaded := loadOpts.OnFileLoaded
+	loadOpts.OnFileLoaded = func(path string) {
+		if onFileLoaded != nil {
+			onFileLoaded(path)
+		}
+		send(Event{Type: EventFileLoaded, File: path})
+	}
+
+	bundle, err := loader.LoadBundleWithOptions(bundlePath, loadOpts)
+	if err != nil {
+		return done(&Result{}, err)
+	}
+
+	ruleList := opts.Rules
+	if ruleList == nil {
+		ruleList = rules.GetAllRules()
+	}
+
+	validation, err := rules.ValidateBundleContext(ctx, bundle, ruleList, rules.ValidateOptions{
+		FailFast: opts.FailFast,
+		Adjust:   opts.Adjust,
+		Logger:   opts.Logger,
+		OnRuleEvaluated: func(rule rules.Rule, violations []rules.Violation) {
+			for i := range violations {
+				v := violations[i]
+				send(Event{Type: EventViolationFound, RuleID: rule.ID(), Violation: &v})
+			}
+			send(Event{Type: EventRuleEvaluated, RuleID: rule.ID(), Count: len(violations)})
+		},
+	})
+
+	return done(&Result{
+		Violations: validation.Violations,
+		StoppedBy:  validation.StoppedBy,
+		Skipped:    validation.Skipped,
+
+		NotApplicable: validation.NotApplicable,
+	}, err)
+}
+
+// Stream lints a bundle in a new goroutine and delivers its events on the
+// returned channel, which is closed after the Done event. If ctx is
+// cancelled, undelivered events are dropped and the channel is closed.
+func Stream(ctx context.Context, bundlePath string, opts Options) <-chan Event {
+	events := make(chan Event, 16)
+	go func() {
+		defer close(events)
+		_, _ = Run(ctx, bundlePath, opts, func(event Event) {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+			}
+		})
+	}()
+	return events
+}
+
+// Lint is a convenience wrapper around Run for callers that only need the
+// final result
+func Lint(bundlePath string, opts Options) (*Result, error) {
+	return Run(context.Background(), bundlePath, opts, nil)
+}
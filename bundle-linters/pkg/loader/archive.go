@@ -0,0 +1,199 @@
+package loader
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// IsArchive reports whether a bundle argument names an image tarball
+// (.tar, .tar.gz or .tgz) rather than a bundle directory
+func IsArchive(arg string) bool {
+	lower := strings.ToLower(arg)
+	for _, suffix := range []string{".tar", ".tar.gz", ".tgz"} {
+		if strings.HasSuffix(lower, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOCILayout reports whether dir is an OCI image layout rather than a
+// bundle directory
+func IsOCILayout(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, "oci-layout"))
+	return err == nil
+}
+
+// LoadBundleFromArchive loads a bundle image saved to disk, either as a
+// 'docker save' tarball or as an OCI image layout (a directory, or a tar
+// of one). Tarballs may be gzip-compressed. The image layers are extracted
+// to a temporary directory which is removed once the manifests are parsed;
+// file paths in the returned bundle are rewritten to be relative to path.
+//
+// When the image has no metadata/annotations.yaml, the bundle annotations
+// are read from the image labels.
+func LoadBundleFromArchive(path string, opts Options) (*rules.Bundle, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("bundle archive does not exist: %s", path)
+	}
+
+	layoutDir := path
+	if !info.IsDir() {
+		layoutDir, err = os.MkdirTemp("", "odhlint-archive-")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+		}
+		defer os.RemoveAll(layoutDir)
+
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open bundle archive: %w", err)
+		}
+		err = extractLayer(f, layoutDir)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to unpack %s: %w", path, err)
+		}
+	}
+
+	layers, labels, err := readArchiveImage(layoutDir)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	dir, err := os.MkdirTemp("", "odhlint-bundle-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, layer := range layers {
+		if err := extractLayerFile(layer, dir); err != nil {
+			return nil, fmt.Errorf("failed to extract layer %s: %w", filepath.Base(layer), err)
+		}
+	}
+
+	bundle, err := LoadBundleWithOptions(dir, opts)
+	if err != nil {
+		return nil, fmt.Errorf("archive %s: %w", path, err)
+	}
+
+	relocateBundle(bundle, dir, path)
+	applyLabelAnnotations(bundle, labels, path)
+	return bundle, nil
+}
+
+// readArchiveImage returns the layer files and labels of the single image
+// stored in an unpacked 'docker save' tarball or OCI image layout
+func readArchiveImage(dir string) ([]string, map[string]string, error) {
+	// docker save writes manifest.json; newer versions add an OCI
+	// index.json alongside it, so check for manifest.json first
+	if data, err := os.ReadFile(filepath.Join(dir, "manifest.json")); err == nil {
+		var images []struct {
+			Config string   `json:"Config"`
+			Layers []string `json:"Layers"`
+		}
+		if err := json.Unmarshal(data, &images); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse manifest.json: %w", err)
+		}
+		if len(images) != 1 {
+			return nil, nil, fmt.Errorf("archive contains %d images, expected exactly one", len(images))
+		}
+
+		var layers []string
+		for _, layer := range images[0].Layers {
+			layers = append(layers, archivePath(dir, layer))
+		}
+		return layers, readArchiveLabels(archivePath(dir, images[0].Config)), nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "index.json"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("not a docker save tarball or OCI image layout (no manifest.json or index.json)")
+	}
+
+	// Follow nested indexes down to a single image manifest
+	var manifest ociManifest
+	for {
+		manifest = ociManifest{}
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse image manifest: %w", err)
+		}
+		if len(manifest.Manifests) == 0 {
+			break
+		}
+		if data, err = readArchiveBlob(dir, selectPlatformManifest(manifest.Manifests)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(manifest.Layers) == 0 {
+		return nil, nil, fmt.Errorf("image has no layers")
+	}
+
+	var layers []string
+	for _, layer := range manifest.Layers {
+		layers = append(layers, blobPath(dir, layer.Digest))
+	}
+
+	var labels map[string]string
+	if manifest.Config.Digest != "" {
+		labels = readArchiveLabels(blobPath(dir, manifest.Config.Digest))
+	}
+	return layers, labels, nil
+}
+
+// readArchiveBlob reads a blob from an OCI image layout and verifies its
+// digest
+func readArchiveBlob(dir, digest string) ([]byte, error) {
+	data, err := os.ReadFile(blobPath(dir, digest))
+	if err != nil {
+		return nil, fmt.Errorf("missing blob %s", digest)
+	}
+	if err := verifyDigest(digest, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// readArchiveLabels returns the labels from an image config file. Labels
+// are optional, so unreadable configs yield none.
+func readArchiveLabels(path string) map[string]string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var config imageConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil
+	}
+	return config.Config.Labels
+}
+
+// blobPath returns the file of a blob in an OCI image layout, e.g.
+// blobs/sha256/<hex> for sha256:<hex>
+func blobPath(dir, digest string) string {
+	algorithm, encoded, _ := strings.Cut(digest, ":")
+	return archivePath(dir, filepath.Join("blobs", algorithm, encoded))
+}
+
+// archivePath resolves a path named inside an archive, keeping it within
+// dir
+func archivePath(dir, name string) string {
+	return filepath.Join(dir, filepath.Clean("/"+name))
+}
+
+func extractLayerFile(path, dir string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return extractLayer(f, dir)
+}
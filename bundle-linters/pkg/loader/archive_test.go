@@ -0,0 +1,171 @@
+package loader
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCSV = `apiVersion: operators.coreos.com/v1alpha1
+kind: ClusterServiceVersion
+metadata:
+  name: example-operator.v1.0.0
+spec:
+  version: 1.0.0
+`
+
+const testAnnotations = `annotations:
+  operators.operatorframework.io.bundle.mediatype.v1: registry+v1
+  operators.operatorframework.io.bundle.manifests.v1: manifests/
+  operators.operatorframework.io.bundle.metadata.v1: metadata/
+  operators.operatorframework.io.bundle.package.v1: example-operator
+`
+
+// tarEntry is one file or directory of a tar built by buildTar; entries
+// without content and with a trailing slash are directories
+type tarEntry struct {
+	name    string
+	content string
+}
+
+// buildTar returns a tar holding entries, in order
+func buildTar(t *testing.T, entries ...tarEntry) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Mode: 0o644, Typeflag: tar.TypeReg, Size: int64(len(entry.content))}
+		if strings.HasSuffix(entry.name, "/") {
+			header = &tar.Header{Name: entry.name, Mode: 0o755, Typeflag: tar.TypeDir}
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(entry.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// writeDockerSave writes a 'docker save' tarball with a single layer to
+// dir and returns its path; name picks the file extension, and .gz names
+// are gzip-compressed
+func writeDockerSave(t *testing.T, dir, name string, layer []byte) string {
+	t.Helper()
+
+	data := buildTar(t,
+		tarEntry{"manifest.json", `[{"Config":"config.json","Layers":["layer.tar"]}]`},
+		tarEntry{"config.json", `{"config":{"Labels":{}}}`},
+		tarEntry{"layer.tar", string(layer)},
+	)
+	if strings.HasSuffix(name, ".gz") {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		if _, err := gz.Write(data); err != nil {
+			t.Fatal(err)
+		}
+		if err := gz.Close(); err != nil {
+			t.Fatal(err)
+		}
+		data = buf.Bytes()
+	}
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadBundleFromArchive(t *testing.T) {
+	layer := buildTar(t,
+		tarEntry{"manifests/", ""},
+		tarEntry{"manifests/example-operator.clusterserviceversion.yaml", testCSV},
+		tarEntry{"metadata/", ""},
+		tarEntry{"metadata/annotations.yaml", testAnnotations},
+	)
+
+	for _, name := range []string{"bundle.tar", "bundle.tar.gz"} {
+		t.Run(name, func(t *testing.T) {
+			path := writeDockerSave(t, t.TempDir(), name, layer)
+			if !IsArchive(path) {
+				t.Fatalf("IsArchive(%q) = false", path)
+			}
+
+			bundle, err := LoadBundleFromArchive(path, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bundle.CSV == nil || bundle.CSV.Metadata.Name != "example-operator.v1.0.0" {
+				t.Fatalf("CSV was not loaded from the archive: %+v", bundle.CSV)
+			}
+			want := filepath.Join(path, "manifests", "example-operator.clusterserviceversion.yaml")
+			if bundle.CSV.FilePath != want {
+				t.Errorf("CSV path = %q, want %q", bundle.CSV.FilePath, want)
+			}
+			if bundle.Annotations == nil || bundle.Annotations.Package != "example-operator" {
+				t.Errorf("annotations were not loaded from the archive: %+v", bundle.Annotations)
+			}
+		})
+	}
+}
+
+func TestLoadBundleFromArchiveRejectsTraversal(t *testing.T) {
+	layer := buildTar(t,
+		tarEntry{"manifests/example-operator.clusterserviceversion.yaml", testCSV},
+		tarEntry{"manifests/../../escaped.yaml", "escaped: true\n"},
+	)
+	dir := t.TempDir()
+	path := writeDockerSave(t, dir, "bundle.tar", layer)
+
+	_, err := LoadBundleFromArchive(path, Options{})
+	if err == nil {
+		t.Fatal("LoadBundleFromArchive() succeeded, want an error")
+	}
+	if !strings.Contains(err.Error(), "outside the archive root") {
+		t.Errorf("error = %q, want it to report the escaping entry", err)
+	}
+	if _, err := os.Stat(filepath.Join(os.TempDir(), "escaped.yaml")); err == nil {
+		t.Error("escaping entry was written outside the extraction directory")
+	}
+}
+
+func TestExtractLayerWhiteouts(t *testing.T) {
+	dir := t.TempDir()
+	layers := [][]byte{
+		buildTar(t,
+			tarEntry{"manifests/old.yaml", "old: true\n"},
+			tarEntry{"manifests/nested/old.yaml", "old: true\n"},
+			tarEntry{"manifests/removed.yaml", "removed: true\n"},
+			tarEntry{"metadata/annotations.yaml", testAnnotations},
+		),
+		buildTar(t,
+			tarEntry{"manifests/new.yaml", "new: true\n"},
+			tarEntry{"manifests/.wh..wh..opq", ""},
+			tarEntry{"metadata/.wh.annotations.yaml", ""},
+		),
+	}
+	for _, layer := range layers {
+		if err := extractLayer(bytes.NewReader(layer), dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, name := range []string{"manifests/old.yaml", "manifests/nested", "manifests/removed.yaml", "metadata/annotations.yaml"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			t.Errorf("%s from the earlier layer was not removed", name)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "manifests", "new.yaml")); err != nil {
+		t.Errorf("manifests/new.yaml from the opaque layer was removed: %v", err)
+	}
+}
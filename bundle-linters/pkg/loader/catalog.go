@@ -0,0 +1,183 @@
+package loader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+	"gopkg.in/yaml.v3"
+)
+
+// Labels catalog images use to locate their content
+const (
+	catalogConfigsLabel  = "operators.operatorframework.io.index.configs.v1"
+	catalogDatabaseLabel = "operators.operatorframework.io.index.database.v1"
+	defaultConfigsDir    = "/configs"
+)
+
+// catalogBlob is a single entry of a file-based catalog. Only the fields
+// needed to find a bundle image are decoded.
+type catalogBlob struct {
+	Schema     string            `json:"schema" yaml:"schema"`
+	Name       string            `json:"name" yaml:"name"`
+	Package    string            `json:"package" yaml:"package"`
+	Image      string            `json:"image" yaml:"image"`
+	Properties []catalogProperty `json:"properties" yaml:"properties"`
+}
+
+type catalogProperty struct {
+	Type  string                 `json:"type" yaml:"type"`
+	Value map[string]interface{} `json:"value" yaml:"value"`
+}
+
+// LoadBundleFromCatalog pulls a catalog (index) image, looks up the bundle
+// of packageName at version in its file-based catalog and loads that
+// bundle image. A leading "v" in version is ignored. File paths in the
+// returned bundle are relative to the bundle image reference.
+//
+// SQLite-based index images are not supported; migrate them to a
+// file-based catalog with 'opm migrate' first.
+func LoadBundleFromCatalog(catalogRef, packageName, version string, opts ImageOptions) (*rules.Bundle, error) {
+	parsed, err := parseImageReference(strings.TrimPrefix(catalogRef, ImageScheme))
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "odhlint-catalog-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	client := newRegistryClient(parsed, opts.Registry)
+	labels, err := client.pullImage(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull %s: %w", parsed, err)
+	}
+
+	configsDir := labels[catalogConfigsLabel]
+	if configsDir == "" {
+		if labels[catalogDatabaseLabel] != "" {
+			return nil, fmt.Errorf("catalog %s is a SQLite-based index, which is not supported; migrate it to a file-based catalog with 'opm migrate'", parsed)
+		}
+		configsDir = defaultConfigsDir
+	}
+
+	root := filepath.Join(dir, filepath.Clean("/"+configsDir))
+	if info, err := os.Stat(root); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("catalog %s has no file-based catalog at %s", parsed, configsDir)
+	}
+
+	image, err := findCatalogBundle(root, packageName, version)
+	if err != nil {
+		return nil, fmt.Errorf("catalog %s: %w", parsed, err)
+	}
+
+	return LoadBundleFromImage(ImageScheme+image, opts)
+}
+
+// findCatalogBundle returns the image of the bundle for packageName at
+// version in the file-based catalog rooted at dir
+func findCatalogBundle(dir, packageName, version string) (string, error) {
+	blobs, err := readCatalogBlobs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	wanted := strings.TrimPrefix(version, "v")
+	packageFound := false
+	var available []string
+	for _, blob := range blobs {
+		switch blob.Schema {
+		case "olm.package":
+			if blob.Name == packageName {
+				packageFound = true
+			}
+		case "olm.bundle":
+			if blob.Package != packageName {
+				continue
+			}
+			packageFound = true
+			bundleVersion := blob.version()
+			if strings.TrimPrefix(bundleVersion, "v") == wanted {
+				if blob.Image == "" {
+					return "", fmt.Errorf("bundle %s of package %q has no image", blob.Name, packageName)
+				}
+				return blob.Image, nil
+			}
+			if bundleVersion != "" {
+				available = append(available, bundleVersion)
+			}
+		}
+	}
+
+	if !packageFound {
+		return "", fmt.Errorf("package %q not found", packageName)
+	}
+	if len(available) == 0 {
+		return "", fmt.Errorf("package %q has no bundles", packageName)
+	}
+	sort.Strings(available)
+	return "", fmt.Errorf("version %q of package %q not found (available: %s)", version, packageName, strings.Join(available, ", "))
+}
+
+// version returns the bundle version from its olm.package property
+func (b catalogBlob) version() string {
+	for _, property := range b.Properties {
+		if property.Type == "olm.package" {
+			if version, ok := property.Value["version"]; ok {
+				return fmt.Sprint(version)
+			}
+		}
+	}
+	return ""
+}
+
+// readCatalogBlobs decodes every JSON and YAML file below dir. JSON files
+// may hold several concatenated objects and YAML files several documents,
+// as written by 'opm render'.
+func readCatalogBlobs(dir string) ([]catalogBlob, error) {
+	var blobs []catalogBlob
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		ext := strings.ToLower(filepath.Ext(path))
+		if ext != ".json" && ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		var decode func(interface{}) error
+		if ext == ".json" {
+			decode = json.NewDecoder(f).Decode
+		} else {
+			decode = yaml.NewDecoder(f).Decode
+		}
+		for {
+			var blob catalogBlob
+			if err := decode(&blob); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				rel, _ := filepath.Rel(dir, path)
+				return fmt.Errorf("failed to parse catalog file %s: %w", rel, err)
+			}
+			blobs = append(blobs, blob)
+		}
+	})
+	return blobs, err
+}
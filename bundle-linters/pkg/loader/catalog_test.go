@@ -0,0 +1,171 @@
+package loader
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFindCatalogBundle(t *testing.T) {
+	tests := []struct {
+		name        string
+		packageName string
+		version     string
+		want        string
+		wantErr     string
+	}{
+		{name: "yaml catalog", packageName: "example-operator", version: "1.1.0", want: "quay.io/example/example-operator-bundle:v1.1.0"},
+		{name: "leading v", packageName: "example-operator", version: "v1.0.0", want: "quay.io/example/example-operator-bundle:v1.0.0"},
+		{name: "json catalog", packageName: "other-operator", version: "0.3.0", want: "quay.io/example/other-operator-bundle:v0.3.0"},
+		{name: "unknown package", packageName: "missing-operator", version: "1.0.0", wantErr: `package "missing-operator" not found`},
+		{name: "unknown version", packageName: "example-operator", version: "2.0.0", wantErr: `version "2.0.0" of package "example-operator" not found (available: 1.0.0, 1.1.0)`},
+		{name: "package without bundles", packageName: "empty-operator", version: "1.0.0", wantErr: `package "empty-operator" has no bundles`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findCatalogBundle(filepath.Join("testdata", "catalog"), tt.packageName, tt.version)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("findCatalogBundle() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("findCatalogBundle() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// testRegistry serves single-layer images over plain HTTP, like a local
+// registry started for testing
+type testRegistry struct {
+	server    *httptest.Server
+	manifests map[string][]byte // Keyed by "<repository>:<tag>"
+	blobs     map[string][]byte // Keyed by digest
+}
+
+func newTestRegistry(t *testing.T) *testRegistry {
+	r := &testRegistry{manifests: map[string][]byte{}, blobs: map[string][]byte{}}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		path := strings.TrimPrefix(req.URL.Path, "/v2/")
+		var data []byte
+		if repository, tag, ok := strings.Cut(path, "/manifests/"); ok {
+			data = r.manifests[repository+":"+tag]
+			w.Header().Set("Content-Type", mediaTypeOCIManifest)
+		} else if _, digest, ok := strings.Cut(path, "/blobs/"); ok {
+			data = r.blobs[digest]
+		}
+		if data == nil {
+			http.NotFound(w, req)
+			return
+		}
+		w.Write(data)
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+// host returns the registry address to use in image references
+func (r *testRegistry) host() string {
+	return strings.TrimPrefix(r.server.URL, "http://")
+}
+
+// push stores an image with one layer and the given labels, and returns
+// its reference
+func (r *testRegistry) push(t *testing.T, repository string, layer []byte, labels map[string]string) string {
+	t.Helper()
+
+	var config imageConfig
+	config.Config.Labels = labels
+	configData, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manifest := ociManifest{
+		MediaType: mediaTypeOCIManifest,
+		Config:    ociDescriptor{Digest: r.addBlob(configData)},
+		Layers:    []ociDescriptor{{Digest: r.addBlob(layer)}},
+	}
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.manifests[repository+":latest"] = data
+	return r.host() + "/" + repository + ":latest"
+}
+
+func (r *testRegistry) addBlob(data []byte) string {
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+	r.blobs[digest] = data
+	return digest
+}
+
+func TestLoadBundleFromCatalog(t *testing.T) {
+	registry := newTestRegistry(t)
+	opts := ImageOptions{Registry: RegistryOptions{Insecure: true, Retries: 1}}
+
+	bundleRef := registry.push(t, "example/example-operator-bundle", buildTar(t,
+		tarEntry{"manifests/example-operator.clusterserviceversion.yaml", testCSV},
+		tarEntry{"metadata/annotations.yaml", testAnnotations},
+	), nil)
+
+	// Point the fixture catalog at the bundle image in the test registry
+	fbc, err := os.ReadFile(filepath.Join("testdata", "catalog", "example-operator", "catalog.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fbc = []byte(strings.ReplaceAll(string(fbc), "quay.io/example/example-operator-bundle:v1.1.0", bundleRef))
+	catalogRef := registry.push(t, "example/catalog", buildTar(t,
+		tarEntry{"catalog/example-operator/catalog.yaml", string(fbc)},
+	), map[string]string{catalogConfigsLabel: "/catalog"})
+
+	sqliteRef := registry.push(t, "example/sqlite-index", buildTar(t,
+		tarEntry{"database/index.db", "SQLite format 3"},
+	), map[string]string{catalogDatabaseLabel: "/database/index.db"})
+
+	t.Run("bundle found", func(t *testing.T) {
+		bundle, err := LoadBundleFromCatalog(ImageScheme+catalogRef, "example-operator", "v1.1.0", opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bundle.CSV == nil || bundle.CSV.Metadata.Name != "example-operator.v1.0.0" {
+			t.Fatalf("CSV was not loaded from the bundle image: %+v", bundle.CSV)
+		}
+		if !strings.HasPrefix(bundle.CSV.FilePath, bundleRef) {
+			t.Errorf("CSV path = %q, want it relative to %s", bundle.CSV.FilePath, bundleRef)
+		}
+	})
+
+	t.Run("version not found", func(t *testing.T) {
+		_, err := LoadBundleFromCatalog(ImageScheme+catalogRef, "example-operator", "9.9.9", opts)
+		if err == nil || !strings.Contains(err.Error(), `version "9.9.9" of package "example-operator" not found`) {
+			t.Errorf("error = %v, want a version not found error", err)
+		}
+	})
+
+	t.Run("package not found", func(t *testing.T) {
+		_, err := LoadBundleFromCatalog(ImageScheme+catalogRef, "missing-operator", "1.0.0", opts)
+		if err == nil || !strings.Contains(err.Error(), `package "missing-operator" not found`) {
+			t.Errorf("error = %v, want a package not found error", err)
+		}
+	})
+
+	t.Run("SQLite index rejected", func(t *testing.T) {
+		_, err := LoadBundleFromCatalog(ImageScheme+sqliteRef, "example-operator", "1.0.0", opts)
+		if err == nil || !strings.Contains(err.Error(), "SQLite-based index, which is not supported") {
+			t.Errorf("error = %v, want the SQLite index to be rejected", err)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package loader
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DiscoverBundles returns every bundle directory at or below root, in
+// lexical order. A bundle directory contains both manifests/ and
+// metadata/ subdirectories; requiring both keeps kubebuilder's
+// config/manifests from being mistaken for a bundle. Bundles are not
+// searched for nested bundles, and hidden directories such as .git are
+// skipped.
+func DiscoverBundles(root string) ([]string, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("bundle path does not exist: %s", root)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("not a directory: %s", root)
+	}
+
+	var bundles []string
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		if path != root && strings.HasPrefix(entry.Name(), ".") {
+			return filepath.SkipDir
+		}
+		if isBundleDir(path) {
+			bundles = append(bundles, path)
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search %s for bundles: %w", root, err)
+	}
+
+	if len(bundles) == 0 {
+		return nil, fmt.Errorf("no bundles (directories with manifests/ and metadata/) found under %s", root)
+	}
+	return bundles, nil
+}
+
+// isBundleDir reports whether dir has manifests/ and metadata/
+// subdirectories
+func isBundleDir(dir string) bool {
+	for _, name := range []string{"manifests", "metadata"} {
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil || !info.IsDir() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package loader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// IsManifestFile reports whether a bundle argument names a single YAML
+// manifest, such as a lone CSV or CRD, rather than a bundle directory
+func IsManifestFile(arg string) bool {
+	info, err := os.Stat(arg)
+	if err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	lower := strings.ToLower(arg)
+	return strings.HasSuffix(lower, ".yaml") || strings.HasSuffix(lower, ".yml")
+}
+
+// LoadManifestFile loads a single manifest file into a minimal bundle with
+// no annotations and no other resources, for ad-hoc checks of a CSV or CRD
+// outside its bundle. The bundle is marked SingleManifest so rules that
+// look for other files of the bundle skip it. opts.FileFilter is not
+// applied, since the file was named explicitly.
+func LoadManifestFile(path string, opts Options) (*rules.Bundle, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve manifest path: %w", err)
+	}
+	if _, err := os.Stat(absPath); os.IsNotExist(err) {
+		return nil, fmt.Errorf("manifest file does not exist: %s", absPath)
+	}
+
+	dir := filepath.Dir(absPath)
+	bundle := &rules.Bundle{
+		Path:           dir,
+		ManifestsPath:  dir,
+		MetadataPath:   filepath.Join(dir, "metadata"),
+		SingleManifest: true,
+	}
+
+	var problems []rules.YAMLProblem
+	if opts.YAMLLint {
+		if problems, err = lintYAMLFile(absPath); err != nil {
+			return nil, fmt.Errorf("failed to load manifest %s: %w", filepath.Base(absPath), err)
+		}
+		bundle.YAMLProblems = problems
+	}
+	if err := loadManifestFile(bundle, absPath, opts); err != nil {
+		if len(problems) > 0 {
+			opts.debug("skipped file", "file", absPath, "reason", "YAML problems", "error", err)
+			return bundle, nil
+		}
+		return nil, fmt.Errorf("failed to load manifest %s: %w", filepath.Base(absPath), err)
+	}
+	if opts.OnFileLoaded != nil {
+		opts.OnFileLoaded(absPath)
+	}
+
+	logResourceCounts(bundle, opts)
+	return bundle, nil
+}
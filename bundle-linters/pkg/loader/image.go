@@ -0,0 +1,201 @@
+package loader
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// ImageScheme prefixes bundle arguments that refer to a registry image
+// rather than a local directory, e.g. docker://quay.io/org/bundle:v1.0.0
+const ImageScheme = "docker://"
+
+// ImageOptions controls how a bundle image is pulled and loaded
+type ImageOptions struct {
+	Options
+	Registry RegistryOptions
+}
+
+// IsImageReference reports whether a bundle argument names a registry image
+func IsImageReference(arg string) bool {
+	return strings.HasPrefix(arg, ImageScheme)
+}
+
+// LoadBundleFromImage pulls a bundle image from a registry and loads it.
+// The image layers are extracted to a temporary directory which is removed
+// once the manifests are parsed; file paths in the returned bundle are
+// rewritten to be relative to the image reference.
+//
+// When the image has no metadata/annotations.yaml, the bundle annotations
+// are read from the image labels.
+//
+// Authentication failures are reported as *AuthError and unreachable
+// registries as *NetworkError.
+func LoadBundleFromImage(ref string, opts ImageOptions) (*rules.Bundle, error) {
+	parsed, err := parseImageReference(strings.TrimPrefix(ref, ImageScheme))
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "odhlint-bundle-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	client := newRegistryClient(parsed, opts.Registry)
+	labels, err := client.pullImage(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull %s: %w", parsed, err)
+	}
+
+	bundle, err := LoadBundleWithOptions(dir, opts.Options)
+	if err != nil {
+		return nil, fmt.Errorf("image %s: %w", parsed, err)
+	}
+
+	relocateBundle(bundle, dir, parsed.String())
+	applyLabelAnnotations(bundle, labels, parsed.String())
+	return bundle, nil
+}
+
+// relocateBundle rewrites the file paths recorded in a bundle from one
+// root to another, so violations point at the original source
+func relocateBundle(bundle *rules.Bundle, from, to string) {
+	rewrite := func(path string) string {
+		if rel, err := filepath.Rel(from, path); err == nil && !strings.HasPrefix(rel, "..") {
+			return to + "/" + filepath.ToSlash(rel)
+		}
+		return path
+	}
+
+	bundle.Path = to
+	bundle.ManifestsPath = rewrite(bundle.ManifestsPath)
+	bundle.MetadataPath = rewrite(bundle.MetadataPath)
+	if bundle.CSV != nil {
+		bundle.CSV.FilePath = rewrite(bundle.CSV.FilePath)
+	}
+	for _, crd := range bundle.CRDs {
+		crd.FilePath = rewrite(crd.FilePath)
+	}
+	for _, resource := range bundle.OtherResources {
+		resource.FilePath = rewrite(resource.FilePath)
+	}
+	if bundle.Annotations != nil {
+		bundle.Annotations.FilePath = rewrite(bundle.Annotations.FilePath)
+	}
+	for i := range bundle.YAMLProblems {
+		bundle.YAMLProblems[i].FilePath = rewrite(bundle.YAMLProblems[i].FilePath)
+	}
+}
+
+// extractLayer unpacks a (possibly gzip-compressed) tar layer into dir.
+// Entries that would escape dir are rejected; links and device files are
+// skipped. Whiteout files remove what earlier layers added: .wh.<name>
+// removes one entry, and an opaque whiteout (.wh..wh..opq) empties its
+// directory.
+func extractLayer(r io.Reader, dir string) error {
+	br := bufio.NewReader(r)
+	var reader io.Reader = br
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	// Paths this layer extracted, which an opaque whiteout keeps
+	extracted := make(map[string]bool)
+
+	tr := tar.NewReader(reader)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Absolute names are taken relative to dir; names with ".."
+		// components that climb out of it are not extracted at all
+		name := strings.TrimLeft(filepath.ToSlash(header.Name), "/")
+		if name != "" && !filepath.IsLocal(filepath.FromSlash(name)) {
+			return fmt.Errorf("archive entry %q is outside the archive root", header.Name)
+		}
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		base := filepath.Base(target)
+
+		if strings.HasPrefix(base, ".wh.") {
+			if base == ".wh..wh..opq" {
+				if err := clearOpaqueDir(filepath.Dir(target), extracted); err != nil {
+					return err
+				}
+			} else {
+				os.RemoveAll(filepath.Join(filepath.Dir(target), strings.TrimPrefix(base, ".wh.")))
+			}
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+		for path := target; path != dir && !extracted[path]; path = filepath.Dir(path) {
+			extracted[path] = true
+		}
+	}
+}
+
+// clearOpaqueDir removes what earlier layers put in dir, keeping the
+// entries the current layer has already extracted into it
+func clearOpaqueDir(dir string, extracted map[string]bool) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if !extracted[path] {
+			if err := os.RemoveAll(path); err != nil {
+				return err
+			}
+		} else if entry.IsDir() {
+			if err := clearOpaqueDir(path, extracted); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -2,16 +2,51 @@ package loader
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
 	"gopkg.in/yaml.v3"
 )
 
+// Options controls how a bundle is loaded
+type Options struct {
+	// FileFilter, when set, is called with each manifest file name relative
+	// to the manifests directory; files for which it returns false are skipped
+	FileFilter func(name string) bool
+
+	// OnFileLoaded, when set, is called with the path of each file after it
+	// has been parsed into the bundle
+	OnFileLoaded func(path string)
+
+	// YAMLLint checks each manifest file for tab indentation and duplicate
+	// keys before parsing and records the problems on the bundle. A file
+	// with problems that then fails to parse is skipped instead of failing
+	// the load, so the rest of the bundle is still validated.
+	YAMLLint bool
+
+	// Logger, when set, receives debug records of the files read, the
+	// parser that handled each and the number of resources of each kind
+	Logger *slog.Logger
+}
+
+// debug writes a debug record to the logger, if one is set
+func (o Options) debug(msg string, args ...any) {
+	if o.Logger != nil {
+		o.Logger.Debug(msg, args...)
+	}
+}
+
 // LoadBundle loads an operator bundle from a directory
 func LoadBundle(bundlePath string) (*rules.Bundle, error) {
+	return LoadBundleWithOptions(bundlePath, Options{})
+}
+
+// LoadBundleWithOptions loads an operator bundle from a directory using the given options
+func LoadBundleWithOptions(bundlePath string, opts Options) (*rules.Bundle, error) {
 	// Normalize path
 	absPath, err := filepath.Abs(bundlePath)
 	if err != nil {
@@ -33,15 +68,69 @@ func LoadBundle(bundlePath string) (*rules.Bundle, error) {
 	if err := loadAnnotations(bundle); err != nil {
 		return nil, fmt.Errorf("failed to load annotations: %w", err)
 	}
+	if bundle.Annotations != nil {
+		opts.debug("loaded file", "file", bundle.Annotations.FilePath, "parser", "annotations")
+		if opts.OnFileLoaded != nil {
+			opts.OnFileLoaded(bundle.Annotations.FilePath)
+		}
+	}
 
 	// Load manifests
-	if err := loadManifests(bundle); err != nil {
+	if err := loadManifests(bundle, opts); err != nil {
 		return nil, fmt.Errorf("failed to load manifests: %w", err)
 	}
 
+	logResourceCounts(bundle, opts)
 	return bundle, nil
 }
 
+// logResourceCounts logs how many resources of each kind were loaded
+func logResourceCounts(bundle *rules.Bundle, opts Options) {
+	if opts.Logger == nil {
+		return
+	}
+
+	counts := make(map[string]int)
+	if bundle.CSV != nil {
+		counts[bundle.CSV.Kind]++
+	}
+	for _, crd := range bundle.CRDs {
+		counts[crd.Kind]++
+	}
+	for _, resource := range bundle.OtherResources {
+		counts[resource.Kind]++
+	}
+
+	kinds := make([]string, 0, len(counts))
+	for kind := range counts {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		opts.debug("resources found", "kind", kind, "count", counts[kind])
+	}
+}
+
+// rawSecurityContext is the YAML form of a pod or container security context
+type rawSecurityContext struct {
+	Privileged               *bool  `yaml:"privileged"`
+	AllowPrivilegeEscalation *bool  `yaml:"allowPrivilegeEscalation"`
+	RunAsNonRoot             *bool  `yaml:"runAsNonRoot"`
+	RunAsUser                *int64 `yaml:"runAsUser"`
+}
+
+func (c *rawSecurityContext) toSecurityContext() *rules.SecurityContext {
+	if c == nil {
+		return nil
+	}
+	return &rules.SecurityContext{
+		Privileged:               c.Privileged,
+		AllowPrivilegeEscalation: c.AllowPrivilegeEscalation,
+		RunAsNonRoot:             c.RunAsNonRoot,
+		RunAsUser:                c.RunAsUser,
+	}
+}
+
 // loadAnnotations loads the bundle annotations from metadata/annotations.yaml
 func loadAnnotations(bundle *rules.Bundle) error {
 	annotationsPath := filepath.Join(bundle.MetadataPath, "annotations.yaml")
@@ -60,33 +149,61 @@ func loadAnnotations(bundle *rules.Bundle) error {
 		Annotations map[string]string `yaml:"annotations"`
 	}
 
-	if err := yaml.Unmarshal(data, &raw); err != nil {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse annotations YAML: %w", err)
+	}
+	if err := doc.Decode(&raw); err != nil {
 		return fmt.Errorf("failed to parse annotations YAML: %w", err)
 	}
 
-	bundle.Annotations = &rules.BundleAnnotations{
-		FilePath:      annotationsPath,
-		MediaType:     raw.Annotations["operators.operatorframework.io.bundle.mediatype.v1"],
-		Manifests:     raw.Annotations["operators.operatorframework.io.bundle.manifests.v1"],
-		Metadata:      raw.Annotations["operators.operatorframework.io.bundle.metadata.v1"],
-		Package:       raw.Annotations["operators.operatorframework.io.bundle.package.v1"],
-		DefaultChannel: raw.Annotations["operators.operatorframework.io.bundle.channel.default.v1"],
+	bundle.Annotations = newBundleAnnotations(annotationsPath, raw.Annotations)
+	bundle.Annotations.Node = &doc
+
+	return nil
+}
+
+// newBundleAnnotations builds bundle annotations from the operatorframework
+// keys in values, as found in annotations.yaml or on a bundle image's labels
+func newBundleAnnotations(filePath string, values map[string]string) *rules.BundleAnnotations {
+	annotations := &rules.BundleAnnotations{
+		FilePath:       filePath,
+		MediaType:      values["operators.operatorframework.io.bundle.mediatype.v1"],
+		Manifests:      values["operators.operatorframework.io.bundle.manifests.v1"],
+		Metadata:       values["operators.operatorframework.io.bundle.metadata.v1"],
+		Package:        values["operators.operatorframework.io.bundle.package.v1"],
+		DefaultChannel: values["operators.operatorframework.io.bundle.channel.default.v1"],
 	}
 
 	// Parse channels (comma-separated)
-	if channelsStr := raw.Annotations["operators.operatorframework.io.bundle.channels.v1"]; channelsStr != "" {
+	if channelsStr := values["operators.operatorframework.io.bundle.channels.v1"]; channelsStr != "" {
 		channels := strings.Split(channelsStr, ",")
 		for i, ch := range channels {
 			channels[i] = strings.TrimSpace(ch)
 		}
-		bundle.Annotations.Channels = channels
+		annotations.Channels = channels
 	}
 
-	return nil
+	return annotations
+}
+
+// applyLabelAnnotations falls back to a bundle image's labels when the
+// image has no metadata/annotations.yaml. Bundle images carry the same
+// operatorframework keys as labels. source is recorded as the file path.
+func applyLabelAnnotations(bundle *rules.Bundle, labels map[string]string, source string) {
+	if bundle.Annotations != nil {
+		return
+	}
+	for key := range labels {
+		if strings.HasPrefix(key, "operators.operatorframework.io.bundle.") {
+			bundle.Annotations = newBundleAnnotations(source, labels)
+			return
+		}
+	}
 }
 
 // loadManifests loads all manifest files from the manifests directory
-func loadManifests(bundle *rules.Bundle) error {
+func loadManifests(bundle *rules.Bundle, opts Options) error {
 	if _, err := os.Stat(bundle.ManifestsPath); os.IsNotExist(err) {
 		return fmt.Errorf("manifests directory not found: %s", bundle.ManifestsPath)
 	}
@@ -103,65 +220,95 @@ func loadManifests(bundle *rules.Bundle) error {
 
 		// Only process YAML files
 		if !strings.HasSuffix(file.Name(), ".yaml") && !strings.HasSuffix(file.Name(), ".yml") {
+			opts.debug("skipped file", "file", file.Name(), "reason", "not a YAML file")
+			continue
+		}
+
+		if opts.FileFilter != nil && !opts.FileFilter(file.Name()) {
+			opts.debug("skipped file", "file", file.Name(), "reason", "excluded by file patterns")
 			continue
 		}
 
 		filePath := filepath.Join(bundle.ManifestsPath, file.Name())
-		if err := loadManifestFile(bundle, filePath); err != nil {
+		var problems []rules.YAMLProblem
+		if opts.YAMLLint {
+			if problems, err = lintYAMLFile(filePath); err != nil {
+				return fmt.Errorf("failed to load manifest %s: %w", file.Name(), err)
+			}
+			bundle.YAMLProblems = append(bundle.YAMLProblems, problems...)
+		}
+		if err := loadManifestFile(bundle, filePath, opts); err != nil {
+			if len(problems) > 0 {
+				opts.debug("skipped file", "file", filePath, "reason", "YAML problems", "error", err)
+				continue
+			}
 			return fmt.Errorf("failed to load manifest %s: %w", file.Name(), err)
 		}
+		if opts.OnFileLoaded != nil {
+			opts.OnFileLoaded(filePath)
+		}
 	}
 
 	return nil
 }
 
 // loadManifestFile loads a single manifest file and adds it to the bundle
-func loadManifestFile(bundle *rules.Bundle, filePath string) error {
+func loadManifestFile(bundle *rules.Bundle, filePath string, opts Options) error {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to read file: %w", err)
 	}
 
+	// Parse the document once; the typed parsers decode from the node
+	// tree, which is kept so rules can report source line numbers
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
 	// Parse basic resource structure to determine kind
 	var basic struct {
 		APIVersion string `yaml:"apiVersion"`
 		Kind       string `yaml:"kind"`
 	}
 
-	if err := yaml.Unmarshal(data, &basic); err != nil {
+	if err := doc.Decode(&basic); err != nil {
 		return fmt.Errorf("failed to parse YAML: %w", err)
 	}
 
 	// Route to specific parser based on kind
 	switch basic.Kind {
 	case "ClusterServiceVersion":
-		csv, err := parseCSV(filePath, data)
+		csv, err := parseCSV(filePath, &doc)
 		if err != nil {
 			return fmt.Errorf("failed to parse CSV: %w", err)
 		}
 		bundle.CSV = csv
+		opts.debug("loaded file", "file", filePath, "parser", "csv", "name", csv.Metadata.Name)
 
 	case "CustomResourceDefinition":
-		crd, err := parseCRD(filePath, data)
+		crd, err := parseCRD(filePath, &doc)
 		if err != nil {
 			return fmt.Errorf("failed to parse CRD: %w", err)
 		}
 		bundle.CRDs = append(bundle.CRDs, crd)
+		opts.debug("loaded file", "file", filePath, "parser", "crd", "name", crd.Metadata.Name)
 
 	default:
 		// Parse as generic resource
-		resource, err := parseResource(filePath, data)
+		resource, err := parseResource(filePath, &doc)
 		if err != nil {
 			return fmt.Errorf("failed to parse resource: %w", err)
 		}
 		bundle.OtherResources = append(bundle.OtherResources, resource)
+		opts.debug("loaded file", "file", filePath, "parser", "generic", "kind", resource.Kind, "apiVersion", resource.APIVersion)
 	}
 
 	return nil
 }
 
 // parseCSV parses a ClusterServiceVersion YAML file
-func parseCSV(filePath string, data []byte) (*rules.ClusterServiceVersion, error) {
+func parseCSV(filePath string, doc *yaml.Node) (*rules.ClusterServiceVersion, error) {
 	var raw struct {
 		APIVersion string `yaml:"apiVersion"`
 		Kind       string `yaml:"kind"`
@@ -172,7 +319,10 @@ func parseCSV(filePath string, data []byte) (*rules.ClusterServiceVersion, error
 			Labels      map[string]string `yaml:"labels"`
 		} `yaml:"metadata"`
 		Spec struct {
-			MinKubeVersion string `yaml:"minKubeVersion"`
+			MinKubeVersion string   `yaml:"minKubeVersion"`
+			Version        string   `yaml:"version"`
+			Replaces       string   `yaml:"replaces"`
+			Skips          []string `yaml:"skips"`
 			InstallModes   []struct {
 				Type      string `yaml:"type"`
 				Supported bool   `yaml:"supported"`
@@ -186,6 +336,7 @@ func parseCSV(filePath string, data []byte) (*rules.ClusterServiceVersion, error
 				SideEffects             string   `yaml:"sideEffects"`
 				WebhookPath             string   `yaml:"webhookPath"`
 				ConversionCRDs          []string `yaml:"conversionCRDs"`
+				TimeoutSeconds          *int     `yaml:"timeoutSeconds"`
 				Rules                   []struct {
 					APIGroups   []string `yaml:"apiGroups"`
 					APIVersions []string `yaml:"apiVersions"`
@@ -195,14 +346,18 @@ func parseCSV(filePath string, data []byte) (*rules.ClusterServiceVersion, error
 			} `yaml:"webhookdefinitions"`
 			CustomResourceDefinitions struct {
 				Owned []struct {
-					Name    string `yaml:"name"`
-					Version string `yaml:"version"`
-					Kind    string `yaml:"kind"`
+					Name        string `yaml:"name"`
+					Version     string `yaml:"version"`
+					Kind        string `yaml:"kind"`
+					DisplayName string `yaml:"displayName"`
+					Description string `yaml:"description"`
 				} `yaml:"owned"`
 				Required []struct {
-					Name    string `yaml:"name"`
-					Version string `yaml:"version"`
-					Kind    string `yaml:"kind"`
+					Name        string `yaml:"name"`
+					Version     string `yaml:"version"`
+					Kind        string `yaml:"kind"`
+					DisplayName string `yaml:"displayName"`
+					Description string `yaml:"description"`
 				} `yaml:"required"`
 			} `yaml:"customresourcedefinitions"`
 			Install struct {
@@ -211,24 +366,92 @@ func parseCSV(filePath string, data []byte) (*rules.ClusterServiceVersion, error
 					Deployments []struct {
 						Name string `yaml:"name"`
 						Spec struct {
+							Replicas *int `yaml:"replicas"`
+							Strategy struct {
+								Type          string `yaml:"type"`
+								RollingUpdate *struct {
+									MaxUnavailable string `yaml:"maxUnavailable"`
+									MaxSurge       string `yaml:"maxSurge"`
+								} `yaml:"rollingUpdate"`
+							} `yaml:"strategy"`
 							Template struct {
+								Metadata struct {
+									Labels map[string]string `yaml:"labels"`
+								} `yaml:"metadata"`
 								Spec struct {
 									Containers []struct {
-										Name    string   `yaml:"name"`
-										Image   string   `yaml:"image"`
-										Command []string `yaml:"command"`
-										Args    []string `yaml:"args"`
+										Name      string   `yaml:"name"`
+										Image     string   `yaml:"image"`
+										Command   []string `yaml:"command"`
+										Args      []string `yaml:"args"`
+										Resources struct {
+											Requests map[string]string `yaml:"requests"`
+											Limits   map[string]string `yaml:"limits"`
+										} `yaml:"resources"`
+										SecurityContext *rawSecurityContext `yaml:"securityContext"`
+										ImagePullPolicy string              `yaml:"imagePullPolicy"`
+										LivenessProbe   interface{}         `yaml:"livenessProbe"`
+										ReadinessProbe  interface{}         `yaml:"readinessProbe"`
+										Env             []struct {
+											Name      string `yaml:"name"`
+											Value     string `yaml:"value"`
+											ValueFrom *struct {
+												SecretKeyRef *struct {
+													Name string `yaml:"name"`
+												} `yaml:"secretKeyRef"`
+											} `yaml:"valueFrom"`
+										} `yaml:"env"`
 									} `yaml:"containers"`
+									SecurityContext  *rawSecurityContext `yaml:"securityContext"`
+									ImagePullSecrets []struct {
+										Name string `yaml:"name"`
+									} `yaml:"imagePullSecrets"`
+									ServiceAccountName string `yaml:"serviceAccountName"`
+									Volumes            []struct {
+										Name     string `yaml:"name"`
+										HostPath *struct {
+											Path string `yaml:"path"`
+											Type string `yaml:"type"`
+										} `yaml:"hostPath"`
+										ConfigMap *struct {
+											Name string `yaml:"name"`
+										} `yaml:"configMap"`
+										Secret *struct {
+											SecretName string `yaml:"secretName"`
+										} `yaml:"secret"`
+										EmptyDir *struct {
+											Medium    string `yaml:"medium"`
+											SizeLimit string `yaml:"sizeLimit"`
+										} `yaml:"emptyDir"`
+									} `yaml:"volumes"`
+									HostNetwork bool `yaml:"hostNetwork"`
+									HostPID     bool `yaml:"hostPID"`
+									HostIPC     bool `yaml:"hostIPC"`
 								} `yaml:"spec"`
 							} `yaml:"template"`
 						} `yaml:"spec"`
 					} `yaml:"deployments"`
+					Permissions []struct {
+						ServiceAccountName string `yaml:"serviceAccountName"`
+					} `yaml:"permissions"`
+					ClusterPermissions []struct {
+						ServiceAccountName string `yaml:"serviceAccountName"`
+					} `yaml:"clusterPermissions"`
 				} `yaml:"spec"`
 			} `yaml:"install"`
+			RelatedImages []struct {
+				Name  string `yaml:"name"`
+				Image string `yaml:"image"`
+			} `yaml:"relatedImages"`
 		} `yaml:"spec"`
 	}
 
-	if err := yaml.Unmarshal(data, &raw); err != nil {
+	if err := doc.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	var object map[string]interface{}
+	if err := doc.Decode(&object); err != nil {
 		return nil, err
 	}
 
@@ -244,7 +467,12 @@ func parseCSV(filePath string, data []byte) (*rules.ClusterServiceVersion, error
 		},
 		Spec: rules.CSVSpec{
 			MinKubeVersion: raw.Spec.MinKubeVersion,
+			Version:        raw.Spec.Version,
+			Replaces:       raw.Spec.Replaces,
+			Skips:          raw.Spec.Skips,
 		},
+		Object: object,
+		Node:   doc,
 	}
 
 	// Parse install modes
@@ -266,6 +494,7 @@ func parseCSV(filePath string, data []byte) (*rules.ClusterServiceVersion, error
 			SideEffects:             wd.SideEffects,
 			WebhookPath:             wd.WebhookPath,
 			ConversionCRDs:          wd.ConversionCRDs,
+			TimeoutSeconds:          wd.TimeoutSeconds,
 		}
 
 		for _, rule := range wd.Rules {
@@ -285,9 +514,11 @@ func parseCSV(filePath string, data []byte) (*rules.ClusterServiceVersion, error
 		csv.Spec.CustomResourceDefinitions.Owned = append(
 			csv.Spec.CustomResourceDefinitions.Owned,
 			rules.CRDReference{
-				Name:    owned.Name,
-				Version: owned.Version,
-				Kind:    owned.Kind,
+				Name:        owned.Name,
+				Version:     owned.Version,
+				Kind:        owned.Kind,
+				DisplayName: owned.DisplayName,
+				Description: owned.Description,
 			},
 		)
 	}
@@ -296,13 +527,23 @@ func parseCSV(filePath string, data []byte) (*rules.ClusterServiceVersion, error
 		csv.Spec.CustomResourceDefinitions.Required = append(
 			csv.Spec.CustomResourceDefinitions.Required,
 			rules.CRDReference{
-				Name:    required.Name,
-				Version: required.Version,
-				Kind:    required.Kind,
+				Name:        required.Name,
+				Version:     required.Version,
+				Kind:        required.Kind,
+				DisplayName: required.DisplayName,
+				Description: required.Description,
 			},
 		)
 	}
 
+	// Parse related images
+	for _, related := range raw.Spec.RelatedImages {
+		csv.Spec.RelatedImages = append(csv.Spec.RelatedImages, rules.RelatedImage{
+			Name:  related.Name,
+			Image: related.Image,
+		})
+	}
+
 	// Parse install spec
 	csv.Spec.Install.Strategy = raw.Spec.Install.Strategy
 	for _, dep := range raw.Spec.Install.Spec.Deployments {
@@ -310,7 +551,25 @@ func parseCSV(filePath string, data []byte) (*rules.ClusterServiceVersion, error
 			Name: dep.Name,
 		}
 
+		deployment.Spec.Replicas = dep.Spec.Replicas
+		deployment.Spec.Strategy.Type = dep.Spec.Strategy.Type
+		if ru := dep.Spec.Strategy.RollingUpdate; ru != nil {
+			deployment.Spec.Strategy.RollingUpdate = &rules.RollingUpdateDeployment{
+				MaxUnavailable: ru.MaxUnavailable,
+				MaxSurge:       ru.MaxSurge,
+			}
+		}
+
 		for _, container := range dep.Spec.Template.Spec.Containers {
+			var env []rules.EnvVar
+			for _, variable := range container.Env {
+				envVar := rules.EnvVar{Name: variable.Name, Value: variable.Value}
+				if from := variable.ValueFrom; from != nil && from.SecretKeyRef != nil {
+					envVar.SecretKeyRef = from.SecretKeyRef.Name
+				}
+				env = append(env, envVar)
+			}
+
 			deployment.Spec.Template.Spec.Containers = append(
 				deployment.Spec.Template.Spec.Containers,
 				rules.Container{
@@ -318,18 +577,74 @@ func parseCSV(filePath string, data []byte) (*rules.ClusterServiceVersion, error
 					Image:   container.Image,
 					Command: container.Command,
 					Args:    container.Args,
+					Resources: rules.ResourceRequirements{
+						Requests: container.Resources.Requests,
+						Limits:   container.Resources.Limits,
+					},
+					SecurityContext: container.SecurityContext.toSecurityContext(),
+					ImagePullPolicy: container.ImagePullPolicy,
+					LivenessProbe:   container.LivenessProbe != nil,
+					ReadinessProbe:  container.ReadinessProbe != nil,
+					Env:             env,
 				},
 			)
 		}
 
+		for _, secret := range dep.Spec.Template.Spec.ImagePullSecrets {
+			deployment.Spec.Template.Spec.ImagePullSecrets = append(
+				deployment.Spec.Template.Spec.ImagePullSecrets,
+				secret.Name,
+			)
+		}
+		deployment.Spec.Template.Labels = dep.Spec.Template.Metadata.Labels
+		deployment.Spec.Template.Spec.ServiceAccountName = dep.Spec.Template.Spec.ServiceAccountName
+		deployment.Spec.Template.Spec.SecurityContext = dep.Spec.Template.Spec.SecurityContext.toSecurityContext()
+		deployment.Spec.Template.Spec.HostNetwork = dep.Spec.Template.Spec.HostNetwork
+		deployment.Spec.Template.Spec.HostPID = dep.Spec.Template.Spec.HostPID
+		deployment.Spec.Template.Spec.HostIPC = dep.Spec.Template.Spec.HostIPC
+
+		for _, vol := range dep.Spec.Template.Spec.Volumes {
+			volume := rules.Volume{Name: vol.Name}
+			if vol.HostPath != nil {
+				volume.HostPath = &rules.HostPathVolume{
+					Path: vol.HostPath.Path,
+					Type: vol.HostPath.Type,
+				}
+			}
+			if vol.ConfigMap != nil {
+				volume.ConfigMap = vol.ConfigMap.Name
+			}
+			if vol.Secret != nil {
+				volume.Secret = vol.Secret.SecretName
+			}
+			if vol.EmptyDir != nil {
+				volume.EmptyDir = &rules.EmptyDirVolume{
+					Medium:    vol.EmptyDir.Medium,
+					SizeLimit: vol.EmptyDir.SizeLimit,
+				}
+			}
+			deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, volume)
+		}
+
 		csv.Spec.Install.Spec.Deployments = append(csv.Spec.Install.Spec.Deployments, deployment)
 	}
 
+	for _, perm := range raw.Spec.Install.Spec.Permissions {
+		csv.Spec.Install.Spec.Permissions = append(csv.Spec.Install.Spec.Permissions, rules.Permission{
+			ServiceAccountName: perm.ServiceAccountName,
+		})
+	}
+	for _, perm := range raw.Spec.Install.Spec.ClusterPermissions {
+		csv.Spec.Install.Spec.ClusterPermissions = append(csv.Spec.Install.Spec.ClusterPermissions, rules.Permission{
+			ServiceAccountName: perm.ServiceAccountName,
+		})
+	}
+
 	return csv, nil
 }
 
 // parseCRD parses a CustomResourceDefinition YAML file
-func parseCRD(filePath string, data []byte) (*rules.CustomResourceDefinition, error) {
+func parseCRD(filePath string, doc *yaml.Node) (*rules.CustomResourceDefinition, error) {
 	var raw struct {
 		APIVersion string `yaml:"apiVersion"`
 		Kind       string `yaml:"kind"`
@@ -341,11 +656,13 @@ func parseCRD(filePath string, data []byte) (*rules.CustomResourceDefinition, er
 		} `yaml:"metadata"`
 		Spec struct {
 			Group                 string `yaml:"group"`
+			Scope                 string `yaml:"scope"`
 			PreserveUnknownFields *bool  `yaml:"preserveUnknownFields"`
 			Names                 struct {
-				Kind     string `yaml:"kind"`
-				Plural   string `yaml:"plural"`
-				Singular string `yaml:"singular"`
+				Kind       string   `yaml:"kind"`
+				Plural     string   `yaml:"plural"`
+				Singular   string   `yaml:"singular"`
+				Categories []string `yaml:"categories"`
 			} `yaml:"names"`
 			Versions []struct {
 				Name    string `yaml:"name"`
@@ -361,18 +678,20 @@ func parseCRD(filePath string, data []byte) (*rules.CustomResourceDefinition, er
 							Namespace string `yaml:"namespace"`
 							Path      string `yaml:"path"`
 						} `yaml:"service"`
+						CABundle string `yaml:"caBundle"`
 					} `yaml:"clientConfig"`
 				} `yaml:"webhook"`
 			} `yaml:"conversion"`
 		} `yaml:"spec"`
 	}
 
-	if err := yaml.Unmarshal(data, &raw); err != nil {
+	if err := doc.Decode(&raw); err != nil {
 		return nil, err
 	}
 
 	crd := &rules.CustomResourceDefinition{
 		FilePath:   filePath,
+		Node:       doc,
 		APIVersion: raw.APIVersion,
 		Kind:       raw.Kind,
 		Metadata: rules.Metadata{
@@ -383,11 +702,13 @@ func parseCRD(filePath string, data []byte) (*rules.CustomResourceDefinition, er
 		},
 		Spec: rules.CRDSpec{
 			Group:                 raw.Spec.Group,
+			Scope:                 raw.Spec.Scope,
 			PreserveUnknownFields: raw.Spec.PreserveUnknownFields,
 			Names: rules.CRDNames{
-				Kind:     raw.Spec.Names.Kind,
-				Plural:   raw.Spec.Names.Plural,
-				Singular: raw.Spec.Names.Singular,
+				Kind:       raw.Spec.Names.Kind,
+				Plural:     raw.Spec.Names.Plural,
+				Singular:   raw.Spec.Names.Singular,
+				Categories: raw.Spec.Names.Categories,
 			},
 		},
 	}
@@ -411,7 +732,9 @@ func parseCRD(filePath string, data []byte) (*rules.CustomResourceDefinition, er
 			crd.Spec.Conversion.Webhook = &rules.CRDConversionWebhook{}
 
 			if raw.Spec.Conversion.Webhook.ClientConfig != nil {
-				crd.Spec.Conversion.Webhook.ClientConfig = &rules.WebhookClientConfig{}
+				crd.Spec.Conversion.Webhook.ClientConfig = &rules.WebhookClientConfig{
+					CABundle: raw.Spec.Conversion.Webhook.ClientConfig.CABundle,
+				}
 
 				if raw.Spec.Conversion.Webhook.ClientConfig.Service != nil {
 					crd.Spec.Conversion.Webhook.ClientConfig.Service = &rules.ServiceReference{
@@ -428,7 +751,7 @@ func parseCRD(filePath string, data []byte) (*rules.CustomResourceDefinition, er
 }
 
 // parseResource parses a generic Kubernetes resource YAML file
-func parseResource(filePath string, data []byte) (*rules.Resource, error) {
+func parseResource(filePath string, doc *yaml.Node) (*rules.Resource, error) {
 	var raw struct {
 		APIVersion string                 `yaml:"apiVersion"`
 		Kind       string                 `yaml:"kind"`
@@ -441,7 +764,13 @@ func parseResource(filePath string, data []byte) (*rules.Resource, error) {
 		Spec map[string]interface{} `yaml:"spec"`
 	}
 
-	if err := yaml.Unmarshal(data, &raw); err != nil {
+	if err := doc.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	// Resources such as RBAC roles keep their content outside spec
+	var fields map[string]interface{}
+	if err := doc.Decode(&fields); err != nil {
 		return nil, err
 	}
 
@@ -455,7 +784,9 @@ func parseResource(filePath string, data []byte) (*rules.Resource, error) {
 			Annotations: raw.Metadata.Annotations,
 			Labels:      raw.Metadata.Labels,
 		},
-		Spec: raw.Spec,
+		Spec:   raw.Spec,
+		Fields: fields,
+		Node:   doc,
 	}, nil
 }
 
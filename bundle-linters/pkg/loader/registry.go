@@ -0,0 +1,601 @@
+package loader
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Media types accepted when fetching image manifests
+const (
+	mediaTypeOCIManifest    = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeOCIIndex       = "application/vnd.oci.image.index.v1+json"
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerList     = "application/vnd.docker.distribution.manifest.list.v2+json"
+)
+
+const (
+	defaultRegistry     = "docker.io"
+	defaultRegistryHost = "registry-1.docker.io"
+	defaultRetries      = 3
+	defaultRetryBackoff = time.Second
+)
+
+// AuthError is returned when a registry rejects the supplied credentials or
+// requires credentials that were not provided. Retrying will not help.
+type AuthError struct {
+	Registry string
+	Status   int
+	Message  string
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("registry authentication failed for %s (HTTP %d): %s", e.Registry, e.Status, e.Message)
+}
+
+// NetworkError is returned when a registry could not be reached or kept
+// failing with transient errors after all retries were exhausted
+type NetworkError struct {
+	Registry string
+	Attempts int
+	Err      error
+}
+
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("failed to reach registry %s after %d attempt(s): %v", e.Registry, e.Attempts, e.Err)
+}
+
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
+
+// RegistryOptions controls how images are pulled from a registry
+type RegistryOptions struct {
+	// Username and Password are used for basic auth and token exchange
+	Username string
+	Password string
+
+	// Token is a bearer token sent as-is, bypassing the token exchange
+	Token string
+
+	// Insecure allows plain HTTP and skips TLS certificate verification
+	Insecure bool
+
+	// Retries is the number of retries for transient failures (network
+	// errors, HTTP 429 and 5xx). Zero selects the default.
+	Retries int
+
+	// RetryBackoff is the initial delay between retries; it doubles after
+	// each attempt. Zero selects the default.
+	RetryBackoff time.Duration
+
+	// DockerConfigPath overrides the location of the docker config file
+	// used to look up credentials when none are given explicitly
+	DockerConfigPath string
+}
+
+// imageReference is a parsed image reference such as
+// quay.io/org/bundle:v1.0.0 or quay.io/org/bundle@sha256:...
+type imageReference struct {
+	Registry   string // Host as written, e.g. "quay.io" or "localhost:5000"
+	Repository string
+	Reference  string // Tag or digest
+}
+
+func (r imageReference) String() string {
+	sep := ":"
+	if strings.HasPrefix(r.Reference, "sha256:") {
+		sep = "@"
+	}
+	return fmt.Sprintf("%s/%s%s%s", r.Registry, r.Repository, sep, r.Reference)
+}
+
+// host returns the address to connect to for the registry
+func (r imageReference) host() string {
+	if r.Registry == defaultRegistry {
+		return defaultRegistryHost
+	}
+	return r.Registry
+}
+
+// parseImageReference parses an image reference, applying Docker Hub
+// defaults for the registry, the "library/" namespace and the "latest" tag
+func parseImageReference(ref string) (imageReference, error) {
+	if ref == "" {
+		return imageReference{}, fmt.Errorf("empty image reference")
+	}
+
+	var parsed imageReference
+	name := ref
+
+	if i := strings.Index(name, "@"); i >= 0 {
+		parsed.Reference = name[i+1:]
+		name = name[:i]
+		if !strings.HasPrefix(parsed.Reference, "sha256:") {
+			return imageReference{}, fmt.Errorf("invalid digest in image reference %q", ref)
+		}
+	} else if i := strings.LastIndex(name, ":"); i >= 0 && !strings.Contains(name[i+1:], "/") {
+		// A colon after the last slash is a tag; before it, a registry port
+		parsed.Reference = name[i+1:]
+		name = name[:i]
+	}
+	if parsed.Reference == "" {
+		parsed.Reference = "latest"
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		parsed.Registry = parts[0]
+		parsed.Repository = parts[1]
+	} else {
+		parsed.Registry = defaultRegistry
+		parsed.Repository = name
+		if !strings.Contains(name, "/") {
+			parsed.Repository = "library/" + name
+		}
+	}
+
+	if parsed.Repository == "" {
+		return imageReference{}, fmt.Errorf("invalid image reference %q", ref)
+	}
+
+	return parsed, nil
+}
+
+// ociManifest covers both OCI and Docker v2 image manifests and indexes
+type ociManifest struct {
+	MediaType string          `json:"mediaType"`
+	Layers    []ociDescriptor `json:"layers"`
+	Manifests []ociDescriptor `json:"manifests"`
+	Config    ociDescriptor   `json:"config"`
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Platform  *struct {
+		OS           string `json:"os"`
+		Architecture string `json:"architecture"`
+	} `json:"platform"`
+}
+
+// imageConfig holds the parts of the image config blob we need
+type imageConfig struct {
+	Config struct {
+		Labels map[string]string `json:"Labels"`
+	} `json:"config"`
+}
+
+// registryClient pulls manifests and blobs from a single registry
+type registryClient struct {
+	ref    imageReference
+	opts   RegistryOptions
+	client *http.Client
+	scheme string
+	auth   string // Authorization header value, once negotiated
+}
+
+func newRegistryClient(ref imageReference, opts RegistryOptions) *registryClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	scheme := "https"
+	if opts.Insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // explicitly requested via --insecure
+		scheme = "http"
+	}
+	if opts.Retries <= 0 {
+		opts.Retries = defaultRetries
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = defaultRetryBackoff
+	}
+
+	c := &registryClient{
+		ref:    ref,
+		opts:   opts,
+		client: &http.Client{Transport: transport, Timeout: 5 * time.Minute},
+		scheme: scheme,
+	}
+	if opts.Token != "" {
+		c.auth = "Bearer " + opts.Token
+	}
+	return c
+}
+
+// pullImage downloads the image's layers and labels, extracting the layers
+// into dir
+func (c *registryClient) pullImage(dir string) (map[string]string, error) {
+	manifest, err := c.fetchManifest(c.ref.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve multi-arch indexes to a single image manifest
+	if len(manifest.Manifests) > 0 {
+		digest := selectPlatformManifest(manifest.Manifests)
+		if manifest, err = c.fetchManifest(digest); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(manifest.Layers) == 0 {
+		return nil, fmt.Errorf("image %s has no layers", c.ref)
+	}
+
+	for _, layer := range manifest.Layers {
+		if err := c.extractBlob(layer.Digest, dir); err != nil {
+			return nil, fmt.Errorf("failed to extract layer %s: %w", layer.Digest, err)
+		}
+	}
+
+	var labels map[string]string
+	if manifest.Config.Digest != "" {
+		data, err := c.fetchBlob(manifest.Config.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch image config: %w", err)
+		}
+		var config imageConfig
+		if err := json.Unmarshal(data, &config); err == nil {
+			labels = config.Config.Labels
+		}
+	}
+
+	return labels, nil
+}
+
+// selectPlatformManifest picks the linux/amd64 manifest of an index,
+// falling back to the first entry. Bundle images are usually
+// platform-independent, so any entry contains the same manifests.
+func selectPlatformManifest(manifests []ociDescriptor) string {
+	for _, m := range manifests {
+		if m.Platform != nil && m.Platform.OS == "linux" && m.Platform.Architecture == "amd64" {
+			return m.Digest
+		}
+	}
+	return manifests[0].Digest
+}
+
+func (c *registryClient) fetchManifest(reference string) (*ociManifest, error) {
+	url := fmt.Sprintf("%s://%s/v2/%s/manifests/%s", c.scheme, c.ref.host(), c.ref.Repository, reference)
+	accept := strings.Join([]string{mediaTypeOCIManifest, mediaTypeOCIIndex, mediaTypeDockerManifest, mediaTypeDockerList}, ", ")
+
+	data, err := c.get(url, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest ociManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest for %s: %w", c.ref, err)
+	}
+	return &manifest, nil
+}
+
+func (c *registryClient) fetchBlob(digest string) ([]byte, error) {
+	url := fmt.Sprintf("%s://%s/v2/%s/blobs/%s", c.scheme, c.ref.host(), c.ref.Repository, digest)
+	data, err := c.get(url, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyDigest(digest, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (c *registryClient) extractBlob(digest, dir string) error {
+	data, err := c.fetchBlob(digest)
+	if err != nil {
+		return err
+	}
+	return extractLayer(bytes.NewReader(data), dir)
+}
+
+// get performs a GET request with authentication and retries, returning
+// the response body
+func (c *registryClient) get(url, accept string) ([]byte, error) {
+	var lastErr error
+	backoff := c.opts.RetryBackoff
+	attempts := 0
+
+	for attempt := 0; attempt <= c.opts.Retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		attempts++
+
+		data, retry, err := c.doGet(url, accept)
+		if err == nil {
+			return data, nil
+		}
+		if !retry {
+			return nil, err
+		}
+		lastErr = err
+	}
+
+	return nil, &NetworkError{Registry: c.ref.Registry, Attempts: attempts, Err: lastErr}
+}
+
+// doGet performs a single request. It negotiates authentication on a 401
+// challenge and reports whether a failure is worth retrying.
+func (c *registryClient) doGet(url, accept string) ([]byte, bool, error) {
+	resp, err := c.request(url, accept)
+	if err != nil {
+		return nil, true, err
+	}
+
+	// A 401 with negotiated (non-static) credentials means the token expired
+	// or lacks scope, so negotiate again; a static --registry-token is final
+	if resp.StatusCode == http.StatusUnauthorized && c.opts.Token == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := c.authenticate(challenge); err != nil {
+			return nil, false, err
+		}
+		if resp, err = c.request(url, accept); err != nil {
+			return nil, true, err
+		}
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, true, err
+		}
+		return data, false, nil
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return nil, false, &AuthError{Registry: c.ref.Registry, Status: resp.StatusCode, Message: readErrorBody(resp.Body)}
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, false, fmt.Errorf("image %s not found in registry", c.ref)
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return nil, true, fmt.Errorf("HTTP %d from %s", resp.StatusCode, c.ref.Registry)
+	default:
+		return nil, false, fmt.Errorf("unexpected HTTP %d from %s: %s", resp.StatusCode, c.ref.Registry, readErrorBody(resp.Body))
+	}
+}
+
+func (c *registryClient) request(url, accept string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if c.auth != "" {
+		req.Header.Set("Authorization", c.auth)
+	}
+	return c.client.Do(req)
+}
+
+// authenticate answers a WWW-Authenticate challenge, either with basic
+// credentials or by exchanging them for a bearer token
+func (c *registryClient) authenticate(challenge string) error {
+	username, password := c.credentials()
+	scheme, params := parseChallenge(challenge)
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if username == "" {
+			return &AuthError{Registry: c.ref.Registry, Status: http.StatusUnauthorized, Message: "registry requires credentials; use --registry-auth or docker login"}
+		}
+		c.auth = "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+		return nil
+
+	case "bearer":
+		token, err := c.fetchToken(params, username, password)
+		if err != nil {
+			return err
+		}
+		c.auth = "Bearer " + token
+		return nil
+	}
+
+	return &AuthError{Registry: c.ref.Registry, Status: http.StatusUnauthorized, Message: fmt.Sprintf("unsupported authentication challenge %q", challenge)}
+}
+
+// fetchToken exchanges credentials (or anonymous access) for a bearer token
+func (c *registryClient) fetchToken(params map[string]string, username, password string) (string, error) {
+	realm := params["realm"]
+	if realm == "" {
+		return "", &AuthError{Registry: c.ref.Registry, Status: http.StatusUnauthorized, Message: "bearer challenge without realm"}
+	}
+
+	query := url.Values{}
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", c.ref.Repository)
+	}
+	query.Set("scope", scope)
+
+	req, err := http.NewRequest(http.MethodGet, realm+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	if username != "" {
+		req.SetBasicAuth(username, password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", &NetworkError{Registry: c.ref.Registry, Attempts: 1, Err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", &AuthError{Registry: c.ref.Registry, Status: resp.StatusCode, Message: "token request rejected: " + readErrorBody(resp.Body)}
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to parse token response: %w", err)
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	if body.AccessToken != "" {
+		return body.AccessToken, nil
+	}
+	return "", &AuthError{Registry: c.ref.Registry, Status: resp.StatusCode, Message: "token response did not contain a token"}
+}
+
+// credentials returns explicit credentials, falling back to the docker
+// config file
+func (c *registryClient) credentials() (string, string) {
+	if c.opts.Username != "" {
+		return c.opts.Username, c.opts.Password
+	}
+	username, password, _ := dockerConfigCredentials(c.opts.DockerConfigPath, c.ref.Registry)
+	return username, password
+}
+
+// dockerConfigCredentials looks up credentials for a registry in a docker
+// config file (~/.docker/config.json, $DOCKER_CONFIG/config.json) or a
+// containers auth file ($REGISTRY_AUTH_FILE). Credential helpers are not
+// supported.
+func dockerConfigCredentials(configPath, registry string) (string, string, error) {
+	var candidates []string
+	if configPath != "" {
+		candidates = append(candidates, configPath)
+	} else {
+		if path := os.Getenv("REGISTRY_AUTH_FILE"); path != "" {
+			candidates = append(candidates, path)
+		}
+		if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+			candidates = append(candidates, filepath.Join(dir, "config.json"))
+		}
+		if home, err := os.UserHomeDir(); err == nil {
+			candidates = append(candidates, filepath.Join(home, ".docker", "config.json"))
+		}
+		if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+			candidates = append(candidates, filepath.Join(dir, "containers", "auth.json"))
+		}
+	}
+
+	keys := []string{registry, "https://" + registry}
+	if registry == defaultRegistry {
+		keys = append(keys, "https://index.docker.io/v1/", "index.docker.io", defaultRegistryHost)
+	}
+
+	for _, path := range candidates {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		var config struct {
+			Auths map[string]struct {
+				Auth     string `json:"auth"`
+				Username string `json:"username"`
+				Password string `json:"password"`
+			} `json:"auths"`
+		}
+		if err := json.Unmarshal(data, &config); err != nil {
+			return "", "", fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		for _, key := range keys {
+			entry, ok := config.Auths[key]
+			if !ok {
+				continue
+			}
+			if entry.Username != "" {
+				return entry.Username, entry.Password, nil
+			}
+			decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return "", "", fmt.Errorf("invalid auth entry for %s in %s: %w", key, path, err)
+			}
+			if username, password, ok := strings.Cut(string(decoded), ":"); ok {
+				return username, password, nil
+			}
+		}
+	}
+
+	return "", "", nil
+}
+
+// parseChallenge parses a WWW-Authenticate header such as
+// `Bearer realm="https://auth.example.com/token",service="registry"`
+func parseChallenge(header string) (string, map[string]string) {
+	params := make(map[string]string)
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+
+	for rest != "" {
+		var pair string
+		rest = strings.TrimLeft(rest, " ,")
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				params[strings.TrimSpace(key)] = value[1:]
+				break
+			}
+			pair = value[1 : end+1]
+			rest = value[end+2:]
+		} else {
+			pair, rest, _ = strings.Cut(value, ",")
+		}
+		params[strings.ToLower(strings.TrimSpace(key))] = pair
+	}
+
+	return scheme, params
+}
+
+// verifyDigest checks downloaded content against its sha256 digest
+func verifyDigest(digest string, data []byte) error {
+	expected, ok := strings.CutPrefix(digest, "sha256:")
+	if !ok {
+		return nil // Other algorithms are not verified
+	}
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != expected {
+		return fmt.Errorf("digest mismatch for %s", digest)
+	}
+	return nil
+}
+
+func readErrorBody(body io.Reader) string {
+	data, _ := io.ReadAll(io.LimitReader(body, 512))
+	message := strings.TrimSpace(string(data))
+	if message == "" {
+		return "no details"
+	}
+	return message
+}
+
+// IsAuthError reports whether err was caused by registry authentication
+func IsAuthError(err error) bool {
+	var authErr *AuthError
+	return errors.As(err, &authErr)
+}
+
+// IsNetworkError reports whether err was caused by an unreachable registry
+func IsNetworkError(err error) bool {
+	var netErr *NetworkError
+	return errors.As(err, &netErr)
+}
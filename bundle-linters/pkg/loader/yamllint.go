@@ -0,0 +1,73 @@
+package loader
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+	"gopkg.in/yaml.v3"
+)
+
+// lintYAMLFile checks a manifest file's text for problems that point at
+// hand-editing mistakes: tabs in indentation, which YAML forbids, and
+// duplicate mapping keys, which make it unclear which value was meant
+func lintYAMLFile(filePath string) ([]rules.YAMLProblem, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var problems []rules.YAMLProblem
+	report := func(line int, format string, args ...interface{}) {
+		problems = append(problems, rules.YAMLProblem{
+			FilePath: filePath,
+			Line:     line,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	for i, line := range strings.Split(string(data), "\n") {
+		content := strings.TrimLeft(line, " \t")
+		indent := line[:len(line)-len(content)]
+		if strings.TrimSpace(content) != "" && strings.Contains(indent, "\t") {
+			report(i+1, "Line is indented with a tab")
+		}
+	}
+
+	// Syntax errors, often caused by the tabs above, stop the duplicate key
+	// check; they are reported when the file is parsed
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		if err := decoder.Decode(&doc); err != nil {
+			break
+		}
+		findDuplicateKeys(&doc, report)
+	}
+
+	return problems, nil
+}
+
+// findDuplicateKeys reports every mapping key that repeats an earlier key
+// of the same mapping
+func findDuplicateKeys(node *yaml.Node, report func(line int, format string, args ...interface{})) {
+	if node.Kind == yaml.MappingNode {
+		seen := make(map[string]int)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if key.Kind != yaml.ScalarNode || key.Value == "<<" {
+				continue
+			}
+			if first, ok := seen[key.Value]; ok {
+				report(key.Line, "Duplicate key '%s' (first defined on line %d)", key.Value, first)
+				continue
+			}
+			seen[key.Value] = key.Line
+		}
+	}
+	for _, child := range node.Content {
+		findDuplicateKeys(child, report)
+	}
+}
@@ -0,0 +1,97 @@
+package reporter
+
+import (
+	"fmt"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// AggregateSummary totals the results of a run over several bundles
+type AggregateSummary struct {
+	Bundles      int `json:"bundles"`      // Bundles scanned, including those that could not be linted
+	Passed       int `json:"passed"`       // Bundles that passed validation
+	Failed       int `json:"failed"`       // Bundles with errors, or warnings in strict mode
+	WithWarnings int `json:"withWarnings"` // Bundles that passed with warnings
+	Unlinted     int `json:"unlinted"`     // Bundles that could not be loaded or linted
+	Errors       int `json:"errors"`
+	Warnings     int `json:"warnings"`
+	Info         int `json:"info"`
+}
+
+// Add records a linted bundle's violations. A bundle with errors, or with
+// warnings in strict mode, counts as failed.
+func (s *AggregateSummary) Add(violations []rules.Violation, strict bool) {
+	bundle := summarize(violations, strict)
+	s.Bundles++
+	s.Errors += bundle.Errors
+	s.Warnings += bundle.Warnings
+	s.Info += bundle.Info
+
+	switch {
+	case !bundle.Passed:
+		s.Failed++
+	case bundle.Warnings > 0:
+		s.Passed++
+		s.WithWarnings++
+	default:
+		s.Passed++
+	}
+}
+
+// AddUnlinted records a bundle that could not be loaded or linted
+func (s *AggregateSummary) AddUnlinted() {
+	s.Bundles++
+	s.Unlinted++
+}
+
+// aggregateLine is the record the jsonl format writes for the aggregate
+type aggregateLine struct {
+	Type string `json:"type"`
+	AggregateSummary
+}
+
+// ReportAggregate outputs the totals of a multi-bundle run, after every
+// bundle has been reported. The text format prints a block of counts; jsonl
+// writes an "aggregate" record; github writes the text to the summary
+// writer. The json, sarif and junit documents carry their own totals, so
+// nothing is added to them.
+func (r *Reporter) ReportAggregate(summary AggregateSummary) error {
+	out := r.writer
+	switch r.format {
+	case FormatJSONL:
+		return r.writeJSONLine(aggregateLine{Type: "aggregate", AggregateSummary: summary})
+	case FormatGitHub:
+		if r.summary == nil {
+			return nil
+		}
+		out = r.summary
+	case FormatText:
+		if r.quiet {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	// Color only applies to the writer it was detected for
+	paint := func(color, text string) string {
+		if out != r.writer {
+			return text
+		}
+		return r.paint(color, text)
+	}
+
+	fmt.Fprintf(out, "\n=== Summary: %d bundle(s) ===\n", summary.Bundles)
+	fmt.Fprintf(out, "  %s\n", paint(ansiGreen, fmt.Sprintf("Passed:         %d (%d with warnings)", summary.Passed, summary.WithWarnings)))
+	if summary.Failed > 0 {
+		fmt.Fprintf(out, "  %s\n", paint(ansiRed, fmt.Sprintf("Failed:         %d", summary.Failed)))
+	} else {
+		fmt.Fprintf(out, "  Failed:         %d\n", summary.Failed)
+	}
+	if summary.Unlinted > 0 {
+		fmt.Fprintf(out, "  %s\n", paint(ansiRed, fmt.Sprintf("Not linted:     %d", summary.Unlinted)))
+	}
+	fmt.Fprintf(out, "  Violations:     %d error(s), %d warning(s), %d info\n",
+		summary.Errors, summary.Warnings, summary.Info)
+	return nil
+}
@@ -0,0 +1,62 @@
+package reporter
+
+import (
+	"io"
+	"os"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// ANSI escape sequences used to color the text format
+const (
+	ansiReset  = "\x1b[0m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+	ansiBlue   = "\x1b[34m"
+)
+
+// WithColor overrides whether the text format is colored. By default it is
+// colored when the writer is a terminal and NO_COLOR is not set.
+func (r *Reporter) WithColor(enabled bool) *Reporter {
+	r.colorEnabled = enabled
+	return r
+}
+
+// colorSupported reports whether output to writer should be colored: it
+// must be a terminal, and NO_COLOR (https://no-color.org) must be unset
+// or empty
+func colorSupported(writer io.Writer) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	file, ok := writer.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// colorize wraps text in the color for a severity: red for errors, yellow
+// for warnings and blue for info. Text is returned unchanged when color is
+// disabled.
+func (r *Reporter) colorize(severity rules.Severity, text string) string {
+	switch severity {
+	case rules.SeverityError:
+		return r.paint(ansiRed, text)
+	case rules.SeverityWarning:
+		return r.paint(ansiYellow, text)
+	case rules.SeverityInfo:
+		return r.paint(ansiBlue, text)
+	}
+	return text
+}
+
+// paint wraps text in an ANSI color when color is enabled
+func (r *Reporter) paint(color, text string) string {
+	if !r.colorEnabled {
+		return text
+	}
+	return color + text + ansiReset
+}
@@ -0,0 +1,43 @@
+package reporter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTextNotColoredWhenNotATerminal(t *testing.T) {
+	var out bytes.Buffer
+	r := New(&out)
+	if err := r.Report(bundleViolations("bundle/manifests/pdb.yaml")); err != nil {
+		t.Fatal(err)
+	}
+	r.ReportSummary(bundleViolations("bundle/manifests/pdb.yaml"))
+
+	if strings.Contains(out.String(), "\x1b[") {
+		t.Errorf("output to a buffer contains ANSI escapes:\n%q", out.String())
+	}
+}
+
+func TestTextColoredWithColor(t *testing.T) {
+	var out bytes.Buffer
+	r := New(&out).WithColor(true)
+	if err := r.Report(bundleViolations("bundle/manifests/pdb.yaml")); err != nil {
+		t.Fatal(err)
+	}
+	r.ReportSummary(bundleViolations("bundle/manifests/pdb.yaml"))
+
+	got := out.String()
+	for _, want := range []string{
+		ansiRed + "❌ [ODH-OLM-004] PodDisruptionBudget 'my-pdb' has maxUnavailable set to 0" + ansiReset,
+		ansiBlue + "ℹ️  [ODH-OLM-022] CRD 'widgets.example.com' has no categories" + ansiReset,
+		ansiRed + "❌ Validation failed: 1 error(s), 0 warning(s)" + ansiReset,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output does not contain %q:\n%q", want, got)
+		}
+	}
+	if strings.Contains(got, "   File: "+ansiRed) {
+		t.Errorf("detail lines are colored:\n%q", got)
+	}
+}
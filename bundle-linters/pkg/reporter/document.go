@@ -0,0 +1,72 @@
+package reporter
+
+import (
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// IsDocument reports whether the format writes a single document per run
+// (json, sarif and junit) rather than a stream of lines or annotations
+func (f Format) IsDocument() bool {
+	switch f {
+	case FormatJSON, FormatSARIF, FormatJUnit:
+		return true
+	}
+	return false
+}
+
+// Document collects the results of several bundles for the document
+// formats, so a run over several bundles writes one JSON object, SARIF log
+// or JUnit report instead of one per bundle
+type Document struct {
+	bundles []bundleReport
+}
+
+// bundleReport is one bundle's part of a document
+type bundleReport struct {
+	path string
+
+	// violations carries the linter's severities, which decide counts and
+	// failures; rendered holds the same violations as they are written
+	violations []rules.Violation
+	rendered   []rules.Violation
+
+	// skipped maps the rules that did not run on the bundle to the reason
+	skipped map[string]string
+}
+
+// NewDocument creates an empty document
+func NewDocument() *Document {
+	return &Document{}
+}
+
+// WithDocument makes Report add the bundle's results to doc instead of
+// writing them, when the format is a document format. The bundle path
+// names the bundle in the document; paths options apply to it. Write the
+// document with WriteDocument once every bundle has been reported.
+func (r *Reporter) WithDocument(doc *Document, bundlePath string) *Reporter {
+	r.document = doc
+	r.bundlePath = bundlePath
+	return r
+}
+
+// WriteDocument writes the bundles collected in doc as one document in the
+// reporter's format. Nothing is written when no bundle was collected.
+func (r *Reporter) WriteDocument(doc *Document) error {
+	if len(doc.bundles) == 0 {
+		return nil
+	}
+	return r.writeDocument(doc.bundles)
+}
+
+// writeDocument writes bundles in the reporter's document format
+func (r *Reporter) writeDocument(bundles []bundleReport) error {
+	switch r.format {
+	case FormatJSON:
+		return r.reportJSON(bundles)
+	case FormatSARIF:
+		return r.reportSARIF(bundles)
+	case FormatJUnit:
+		return r.reportJUnit(bundles)
+	}
+	return nil
+}
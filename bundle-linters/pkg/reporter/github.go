@@ -0,0 +1,58 @@
+package reporter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// reportGitHub writes each violation as a GitHub Actions workflow command,
+// e.g. ::error file=bundle/manifests/pdb.yaml,line=6,title=...::message,
+// which the runner turns into an annotation on the pull request diff
+func (r *Reporter) reportGitHub(violations []rules.Violation) error {
+	for _, v := range violations {
+		if _, err := fmt.Fprintln(r.writer, githubCommand(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// githubCommand formats a violation as a workflow command. Severities map
+// to error, warning and notice; anything else (e.g. info) is a notice.
+func githubCommand(v rules.Violation) string {
+	command := "notice"
+	switch v.Severity {
+	case rules.SeverityError, rules.SeverityWarning:
+		command = string(v.Severity)
+	}
+
+	var params []string
+	if v.File != "" {
+		params = append(params, "file="+escapeGitHubProperty(v.File))
+		if v.Line > 0 {
+			params = append(params, "line="+strconv.Itoa(v.Line))
+		}
+	}
+	params = append(params, "title="+escapeGitHubProperty(fmt.Sprintf("%s %s", v.RuleID, v.RuleName)))
+
+	message := v.Message
+	if v.Description != "" {
+		message += "\n" + v.Description
+	}
+	return fmt.Sprintf("::%s %s::%s", command, strings.Join(params, ","), escapeGitHubData(message))
+}
+
+// escapeGitHubData escapes a workflow command message, whose newlines
+// would otherwise end the command
+func escapeGitHubData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeGitHubProperty escapes a workflow command parameter value, where
+// commas and colons also delimit the command
+func escapeGitHubProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
@@ -0,0 +1,41 @@
+package reporter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestReportGitHub(t *testing.T) {
+	violations := append(bundleViolations("bundle/manifests/pdb.yaml"),
+		rules.Violation{
+			RuleID:      "ODH-OLM-001",
+			RuleName:    "missing-minkubeversion",
+			Category:    rules.CategoryOLMRequirement,
+			Severity:    rules.SeverityWarning,
+			Message:     "CSV does not set spec.minKubeVersion: 100% of clusters, any version",
+			Description: "Set minKubeVersion, e.g. 1.25.0",
+			File:        "bundle/manifests/example,operator.clusterserviceversion.yaml",
+		},
+	)
+
+	var out, summary bytes.Buffer
+	r := NewWithFormat(&out, FormatGitHub).WithSummaryWriter(&summary)
+	if err := r.Report(violations); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.ReportSummary(violations); err == nil {
+		t.Error("ReportSummary() = nil, want the error to fail validation")
+	}
+
+	want := "::error file=bundle/manifests/pdb.yaml,line=6,title=ODH-OLM-004 pdb-maxunavailable-zero::PodDisruptionBudget 'my-pdb' has maxUnavailable set to 0\n" +
+		"::notice title=ODH-OLM-022 crd-missing-categories::CRD 'widgets.example.com' has no categories\n" +
+		"::warning file=bundle/manifests/example%2Coperator.clusterserviceversion.yaml,title=ODH-OLM-001 missing-minkubeversion::CSV does not set spec.minKubeVersion: 100%25 of clusters, any version%0ASet minKubeVersion, e.g. 1.25.0\n"
+	if got := out.String(); got != want {
+		t.Errorf("workflow commands:\n%s\nwant:\n%s", got, want)
+	}
+	if !bytes.Contains(summary.Bytes(), []byte("Validation failed: 1 error(s), 1 warning(s)")) {
+		t.Errorf("summary is not written to the summary writer:\n%s", summary.String())
+	}
+}
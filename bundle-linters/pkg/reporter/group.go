@@ -0,0 +1,111 @@
+package reporter
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// GroupBy selects how the text format arranges violations
+type GroupBy string
+
+const (
+	// GroupBySeverity lists every violation, most severe first (default)
+	GroupBySeverity GroupBy = "severity"
+	// GroupByRule prints each rule once with its occurrence count,
+	// followed by the affected locations
+	GroupByRule GroupBy = "rule"
+)
+
+// ParseGroupBy validates a grouping name
+func ParseGroupBy(name string) (GroupBy, error) {
+	switch g := GroupBy(name); g {
+	case GroupBySeverity, GroupByRule:
+		return g, nil
+	}
+	return "", fmt.Errorf("unsupported grouping: %s (expected severity or rule)", name)
+}
+
+// WithGroupBy sets how the text format arranges violations. Other formats
+// are unaffected.
+func (r *Reporter) WithGroupBy(groupBy GroupBy) *Reporter {
+	r.groupBy = groupBy
+	return r
+}
+
+// ruleGroup is the set of violations one rule reported at one severity
+type ruleGroup struct {
+	ruleID     string
+	severity   rules.Severity
+	violations []rules.Violation
+}
+
+// groupByRule groups violations per rule and severity, ordered by
+// severity, then by occurrence count, then by rule ID. Violations within a
+// group are ordered by file and line.
+func groupByRule(violations []rules.Violation) []*ruleGroup {
+	index := make(map[string]*ruleGroup)
+	var groups []*ruleGroup
+	for _, v := range violations {
+		key := v.RuleID + "/" + string(v.Severity)
+		group, ok := index[key]
+		if !ok {
+			group = &ruleGroup{ruleID: v.RuleID, severity: v.Severity}
+			index[key] = group
+			groups = append(groups, group)
+		}
+		group.violations = append(group.violations, v)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		a, b := groups[i], groups[j]
+		if a.severity != b.severity {
+			return severityWeight(a.severity) > severityWeight(b.severity)
+		}
+		if len(a.violations) != len(b.violations) {
+			return len(a.violations) > len(b.violations)
+		}
+		return a.ruleID < b.ruleID
+	})
+	for _, group := range groups {
+		sort.SliceStable(group.violations, func(i, j int) bool {
+			a, b := group.violations[i], group.violations[j]
+			if a.File != b.File {
+				return a.File < b.File
+			}
+			return a.Line < b.Line
+		})
+	}
+
+	return groups
+}
+
+// writeGroupedByRule prints violations grouped per rule: the rule, its
+// count and description once, then one line per occurrence
+func (r *Reporter) writeGroupedByRule(violations []rules.Violation) {
+	for _, group := range groupByRule(violations) {
+		first := group.violations[0]
+		fmt.Fprintf(r.writer, "%s\n", r.colorize(group.severity, fmt.Sprintf("%s [%s] %s: %d occurrence(s)",
+			getSeverityIcon(group.severity), group.ruleID, first.RuleName, len(group.violations))))
+		fmt.Fprintf(r.writer, "   Category: %s\n", first.Category)
+		if first.Description != "" {
+			fmt.Fprintf(r.writer, "   %s\n", first.Description)
+		}
+		if first.Fixable {
+			fmt.Fprintf(r.writer, "   ℹ️  These issues are potentially auto-fixable\n")
+		}
+		for _, v := range group.violations {
+			location := v.File
+			if location != "" && v.Line > 0 {
+				location = fmt.Sprintf("%s:%d", location, v.Line)
+			}
+			if location == "" {
+				fmt.Fprintf(r.writer, "   - %s\n", v.Message)
+			} else {
+				fmt.Fprintf(r.writer, "   - %s: %s\n", location, v.Message)
+			}
+		}
+		fmt.Fprintln(r.writer)
+	}
+}
@@ -0,0 +1,142 @@
+package reporter
+
+import (
+	"encoding/json"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// jsonViolation is the JSON representation of a violation
+type jsonViolation struct {
+	RuleID      string `json:"ruleId"`
+	RuleName    string `json:"ruleName"`
+	Category    string `json:"category"`
+	Severity    string `json:"severity"`
+	Message     string `json:"message"`
+	File        string `json:"file,omitempty"`
+	Line        int    `json:"line,omitempty"`
+	Description string `json:"description,omitempty"`
+	Fixable     bool   `json:"fixable"`
+}
+
+// jsonSummary counts violations by severity
+type jsonSummary struct {
+	Total    int  `json:"total"`
+	Errors   int  `json:"errors"`
+	Warnings int  `json:"warnings"`
+	Info     int  `json:"info"`
+	Passed   bool `json:"passed"`
+}
+
+// jsonReport is the document written by the json format for one bundle
+type jsonReport struct {
+	Violations []jsonViolation `json:"violations"`
+	Summary    jsonSummary     `json:"summary"`
+}
+
+// jsonMultiReport is the document written by the json format when several
+// bundles were linted: each bundle's report, plus the totals of the run
+type jsonMultiReport struct {
+	Bundles []jsonBundleReport `json:"bundles"`
+	Summary jsonSummary        `json:"summary"`
+}
+
+type jsonBundleReport struct {
+	Path string `json:"path"`
+	jsonReport
+}
+
+// jsonViolationLine and jsonSummaryLine are the records written by the
+// jsonl format, tagged with a "type" so consumers can tell them apart
+type jsonViolationLine struct {
+	Type string `json:"type"`
+	jsonViolation
+}
+
+type jsonSummaryLine struct {
+	Type string `json:"type"`
+	jsonSummary
+}
+
+func toJSONViolation(v rules.Violation) jsonViolation {
+	return jsonViolation{
+		RuleID:      v.RuleID,
+		RuleName:    v.RuleName,
+		Category:    string(v.Category),
+		Severity:    string(v.Severity),
+		Message:     v.Message,
+		File:        v.File,
+		Line:        v.Line,
+		Description: v.Description,
+		Fixable:     v.Fixable,
+	}
+}
+
+func summarize(violations []rules.Violation, strict bool) jsonSummary {
+	summary := jsonSummary{Total: len(violations)}
+	for _, v := range violations {
+		switch v.Severity {
+		case rules.SeverityError:
+			summary.Errors++
+		case rules.SeverityWarning:
+			summary.Warnings++
+		case rules.SeverityInfo:
+			summary.Info++
+		}
+	}
+	summary.Passed = summary.Errors == 0 && (!strict || summary.Warnings == 0)
+	return summary
+}
+
+// reportJSON writes the violations and summary as a single JSON document.
+// Several bundles are listed under "bundles", each with its own violations
+// and summary, next to a summary of the whole run.
+func (r *Reporter) reportJSON(bundles []bundleReport) error {
+	var report interface{}
+	if len(bundles) == 1 {
+		report = r.jsonReport(bundles[0])
+	} else {
+		multi := jsonMultiReport{Bundles: make([]jsonBundleReport, 0, len(bundles))}
+		var all []rules.Violation
+		for _, bundle := range bundles {
+			multi.Bundles = append(multi.Bundles, jsonBundleReport{Path: bundle.path, jsonReport: r.jsonReport(bundle)})
+			all = append(all, bundle.violations...)
+		}
+		multi.Summary = summarize(all, r.strict)
+		report = multi
+	}
+
+	encoder := json.NewEncoder(r.writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// jsonReport builds a bundle's JSON report. The summary counts the
+// linter's severities; the violations are listed as rendered.
+func (r *Reporter) jsonReport(bundle bundleReport) jsonReport {
+	report := jsonReport{
+		Violations: make([]jsonViolation, 0, len(bundle.rendered)),
+		Summary:    summarize(bundle.violations, r.strict),
+	}
+	for _, v := range bundle.rendered {
+		report.Violations = append(report.Violations, toJSONViolation(v))
+	}
+	return report
+}
+
+// ReportViolation writes a single violation as one compact JSON line. It
+// lets callers stream violations as they are produced instead of waiting
+// for the full result set.
+func (r *Reporter) ReportViolation(v rules.Violation) error {
+	return r.writeViolationLine(r.renderViolation(v))
+}
+
+// writeViolationLine writes a violation that was already rendered
+func (r *Reporter) writeViolationLine(v rules.Violation) error {
+	return r.writeJSONLine(jsonViolationLine{Type: "violation", jsonViolation: toJSONViolation(v)})
+}
+
+// writeJSONLine encodes a record followed by a newline
+func (r *Reporter) writeJSONLine(record interface{}) error {
+	return json.NewEncoder(r.writer).Encode(record)
+}
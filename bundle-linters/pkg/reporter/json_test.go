@@ -0,0 +1,92 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// decodeOne unmarshals output that must hold exactly one JSON document
+func decodeOne(t *testing.T, data []byte, v interface{}) {
+	t.Helper()
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		t.Fatalf("output does not decode: %v\n%s", err, data)
+	}
+	if decoder.More() {
+		t.Fatalf("output holds more than one JSON document:\n%s", data)
+	}
+}
+
+func TestReportJSONRoundTrip(t *testing.T) {
+	violations := bundleViolations("bundle/manifests/pdb.yaml")
+
+	var out bytes.Buffer
+	if err := NewWithFormat(&out, FormatJSON).Report(violations); err != nil {
+		t.Fatal(err)
+	}
+
+	var report jsonReport
+	decodeOne(t, out.Bytes(), &report)
+
+	want := jsonReport{
+		Violations: []jsonViolation{toJSONViolation(violations[0]), toJSONViolation(violations[1])},
+		Summary:    jsonSummary{Total: 2, Errors: 1, Info: 1, Passed: false},
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("got %+v\nwant %+v", report, want)
+	}
+}
+
+func TestReportJSONEmpty(t *testing.T) {
+	var out bytes.Buffer
+	if err := NewWithFormat(&out, FormatJSON).Report(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var report map[string]interface{}
+	decodeOne(t, out.Bytes(), &report)
+	if violations, ok := report["violations"].([]interface{}); !ok || len(violations) != 0 {
+		t.Errorf("violations = %v, want an empty array", report["violations"])
+	}
+}
+
+func TestReportJSONSeveralBundles(t *testing.T) {
+	doc := NewDocument()
+	bundles := map[string]int{"bundles/a": 2, "bundles/b": 1}
+	for _, bundle := range []string{"bundles/a", "bundles/b"} {
+		violations := bundleViolations(bundle + "/manifests/pdb.yaml")[:bundles[bundle]]
+		rep := NewWithFormat(&bytes.Buffer{}, FormatJSON).WithDocument(doc, bundle)
+		if err := rep.Report(violations); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := NewWithFormat(&out, FormatJSON).WriteDocument(doc); err != nil {
+		t.Fatal(err)
+	}
+
+	var report jsonMultiReport
+	decodeOne(t, out.Bytes(), &report)
+
+	if len(report.Bundles) != 2 {
+		t.Fatalf("got %d bundles, want 2", len(report.Bundles))
+	}
+	for _, bundle := range report.Bundles {
+		if len(bundle.Violations) != bundles[bundle.Path] || bundle.Summary.Total != bundles[bundle.Path] {
+			t.Errorf("bundle %s: %d violation(s), summary total %d, want %d",
+				bundle.Path, len(bundle.Violations), bundle.Summary.Total, bundles[bundle.Path])
+		}
+		if bundle.Violations[0].File != bundle.Path+"/manifests/pdb.yaml" {
+			t.Errorf("bundle %s lists a violation of %s", bundle.Path, bundle.Violations[0].File)
+		}
+	}
+	want := jsonSummary{Total: 3, Errors: 2, Info: 1, Passed: false}
+	if report.Summary != want {
+		t.Errorf("summary = %+v, want %+v", report.Summary, want)
+	}
+}
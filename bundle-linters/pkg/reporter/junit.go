@@ -0,0 +1,188 @@
+package reporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// The types below cover the subset of the JUnit XML format understood by
+// common CI test-report integrations
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut *junitOutput  `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",cdata"`
+}
+
+type junitOutput struct {
+	Text string `xml:",cdata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// WithSkippedRules records which registered rules did not run on the
+// bundle, mapped to the reason, for formats that list every rule (junit).
+// Rules not in the map are reported as run.
+func (r *Reporter) WithSkippedRules(skipped map[string]string) *Reporter {
+	r.skipped = skipped
+	return r
+}
+
+// reportJUnit writes a JUnit XML document with one test case per registered
+// rule. A single bundle gets one test suite per rule category; several
+// bundles get one test suite each, named after the bundle. A rule's test
+// case fails when it reported error-severity violations (or, in strict
+// mode, warnings), listing them in the failure body; other violations go
+// to the test case's output. Rules that did not run are skipped.
+func (r *Reporter) reportJUnit(bundles []bundleReport) error {
+	var suites []*junitTestSuite
+	if len(bundles) == 1 {
+		byCategory := make(map[rules.Category]*junitTestSuite)
+		for _, testCase := range r.junitTestCases(bundles[0]) {
+			category := rules.Category(testCase.ClassName)
+			suite, ok := byCategory[category]
+			if !ok {
+				suite = &junitTestSuite{Name: string(category)}
+				byCategory[category] = suite
+			}
+			suite.add(testCase)
+		}
+		for _, category := range rules.AllCategories() {
+			if suite, ok := byCategory[category]; ok {
+				suites = append(suites, suite)
+			}
+		}
+	} else {
+		for _, bundle := range bundles {
+			suite := &junitTestSuite{Name: bundle.path}
+			for _, testCase := range r.junitTestCases(bundle) {
+				suite.add(testCase)
+			}
+			suites = append(suites, suite)
+		}
+	}
+
+	report := junitTestSuites{Name: "odhlint-bundle"}
+	for _, suite := range suites {
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Skipped += suite.Skipped
+		report.Suites = append(report.Suites, *suite)
+	}
+
+	if _, err := io.WriteString(r.writer, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(r.writer)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(r.writer)
+	return err
+}
+
+// add appends a test case to the suite and counts it
+func (s *junitTestSuite) add(testCase junitTestCase) {
+	s.Tests++
+	if testCase.Failure != nil {
+		s.Failures++
+	}
+	if testCase.Skipped != nil {
+		s.Skipped++
+	}
+	s.Cases = append(s.Cases, testCase)
+}
+
+// junitTestCases returns a bundle's test cases, one per registered rule,
+// classed by rule category. Failures are decided on the linter's
+// severities; the listed violations are rendered.
+func (r *Reporter) junitTestCases(bundle bundleReport) []junitTestCase {
+	byRule := make(map[string][]int)
+	for i, v := range bundle.violations {
+		byRule[v.RuleID] = append(byRule[v.RuleID], i)
+	}
+
+	var testCases []junitTestCase
+	for _, rule := range rules.GetAllRules() {
+		testCase := junitTestCase{
+			Name:      fmt.Sprintf("%s %s", rule.ID(), rule.Name()),
+			ClassName: string(rule.Category()),
+		}
+		if reason, skipped := bundle.skipped[rule.ID()]; skipped {
+			testCase.Skipped = &junitSkipped{Message: reason}
+			testCases = append(testCases, testCase)
+			continue
+		}
+
+		var failures, others []string
+		failureType := ""
+		for _, i := range byRule[rule.ID()] {
+			original, rendered := bundle.violations[i], bundle.rendered[i]
+			if r.fails(original.Severity) {
+				failures = append(failures, junitLine(rendered))
+				if failureType == "" || original.Severity == rules.SeverityError {
+					failureType = string(rendered.Severity)
+				}
+			} else {
+				others = append(others, junitLine(rendered))
+			}
+		}
+		if len(failures) > 0 {
+			testCase.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d violation(s)", len(failures)),
+				Type:    failureType,
+				Text:    strings.Join(failures, "\n"),
+			}
+		}
+		if len(others) > 0 {
+			testCase.SystemOut = &junitOutput{Text: strings.Join(others, "\n")}
+		}
+		testCases = append(testCases, testCase)
+	}
+	return testCases
+}
+
+// junitLine formats a violation as one line of a test case's failure body
+// or output, e.g. "error: bundle/manifests/pdb.yaml:6: message"
+func junitLine(v rules.Violation) string {
+	location := v.File
+	if location != "" && v.Line > 0 {
+		location = fmt.Sprintf("%s:%d", location, v.Line)
+	}
+	if location == "" {
+		return fmt.Sprintf("%s: %s", v.Severity, v.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", v.Severity, location, v.Message)
+}
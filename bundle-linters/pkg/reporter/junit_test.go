@@ -0,0 +1,120 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// parseJUnit decodes a JUnit report and checks it is a single document
+func parseJUnit(t *testing.T, data []byte) junitTestSuites {
+	t.Helper()
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var report junitTestSuites
+	if err := decoder.Decode(&report); err != nil {
+		t.Fatalf("output is not a JUnit document: %v\n%s", err, data)
+	}
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		if _, ok := token.(xml.StartElement); ok {
+			t.Fatalf("output holds more than one document:\n%s", data)
+		}
+	}
+	return report
+}
+
+// findCase returns the test case of a rule in a suite
+func findCase(t *testing.T, suite junitTestSuite, ruleID string) junitTestCase {
+	t.Helper()
+	for _, testCase := range suite.Cases {
+		if strings.HasPrefix(testCase.Name, ruleID+" ") {
+			return testCase
+		}
+	}
+	t.Fatalf("suite %s has no test case for %s", suite.Name, ruleID)
+	return junitTestCase{}
+}
+
+func TestReportJUnit(t *testing.T) {
+	var out bytes.Buffer
+	rep := NewWithFormat(&out, FormatJUnit).WithSkippedRules(map[string]string{"ODH-OLM-001": "not enabled for this run"})
+	if err := rep.Report(bundleViolations("bundle/manifests/pdb.yaml")); err != nil {
+		t.Fatal(err)
+	}
+
+	report := parseJUnit(t, out.Bytes())
+	if len(report.Suites) != len(rules.AllCategories()) {
+		t.Errorf("got %d suites, want one per category (%d)", len(report.Suites), len(rules.AllCategories()))
+	}
+	if report.Tests != len(rules.GetAllRules()) {
+		t.Errorf("tests = %d, want one per rule (%d)", report.Tests, len(rules.GetAllRules()))
+	}
+	if report.Failures != 1 || report.Skipped != 1 {
+		t.Errorf("failures = %d, skipped = %d, want 1 and 1", report.Failures, report.Skipped)
+	}
+
+	for _, suite := range report.Suites {
+		if suite.Name != string(rules.CategoryUpgrade) {
+			continue
+		}
+		failed := findCase(t, suite, "ODH-OLM-004")
+		if failed.Failure == nil {
+			t.Fatal("ODH-OLM-004 did not fail")
+		}
+		if want := "error: bundle/manifests/pdb.yaml:6: PodDisruptionBudget"; !strings.HasPrefix(failed.Failure.Text, want) {
+			t.Errorf("failure body = %q, want prefix %q", failed.Failure.Text, want)
+		}
+	}
+}
+
+func TestReportJUnitSeveralBundles(t *testing.T) {
+	doc := NewDocument()
+	for _, bundle := range []string{"bundles/a", "bundles/b"} {
+		violations := bundleViolations(bundle + "/manifests/pdb.yaml")
+		if bundle == "bundles/b" {
+			violations = violations[1:]
+		}
+		rep := NewWithFormat(&bytes.Buffer{}, FormatJUnit).WithDocument(doc, bundle)
+		if err := rep.Report(violations); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := NewWithFormat(&out, FormatJUnit).WriteDocument(doc); err != nil {
+		t.Fatal(err)
+	}
+
+	report := parseJUnit(t, out.Bytes())
+	if len(report.Suites) != 2 {
+		t.Fatalf("got %d suites, want one per bundle", len(report.Suites))
+	}
+	for i, want := range []struct {
+		name     string
+		failures int
+	}{
+		{"bundles/a", 1},
+		{"bundles/b", 0},
+	} {
+		suite := report.Suites[i]
+		if suite.Name != want.name || suite.Failures != want.failures {
+			t.Errorf("suite %d = %s with %d failure(s), want %s with %d", i, suite.Name, suite.Failures, want.name, want.failures)
+		}
+		if suite.Tests != len(rules.GetAllRules()) {
+			t.Errorf("suite %s has %d tests, want one per rule", suite.Name, suite.Tests)
+		}
+	}
+	if report.Tests != 2*len(rules.GetAllRules()) || report.Failures != 1 {
+		t.Errorf("totals: tests = %d, failures = %d", report.Tests, report.Failures)
+	}
+	if got := findCase(t, report.Suites[1], "ODH-OLM-022"); got.SystemOut == nil {
+		t.Error("info violation of bundles/b is not in the test case output")
+	}
+}
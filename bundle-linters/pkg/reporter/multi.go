@@ -0,0 +1,57 @@
+package reporter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// Output names an additional report destination: a format written to a file
+type Output struct {
+	Format Format
+	Path   string
+}
+
+// ParseOutput parses an output spec of the form format=path, e.g.
+// json=report.json
+func ParseOutput(spec string) (Output, error) {
+	name, path, ok := strings.Cut(spec, "=")
+	if !ok || path == "" {
+		return Output{}, fmt.Errorf("invalid output %q (expected format=path)", spec)
+	}
+	format, err := ParseFormat(name)
+	if err != nil {
+		return Output{}, err
+	}
+	return Output{Format: format, Path: path}, nil
+}
+
+// Multi fans results out to several reporters, so one run can write a
+// human-readable log and machine-readable artifacts at the same time.
+// Every reporter receives every call, even if an earlier one fails; the
+// first error is returned.
+type Multi []*Reporter
+
+// Report outputs validation violations to every reporter
+func (m Multi) Report(violations []rules.Violation) error {
+	var firstErr error
+	for _, r := range m {
+		if err := r.Report(violations); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// ReportSummary outputs a summary of violations to every reporter. Like
+// Reporter.ReportSummary, it returns an error when validation failed.
+func (m Multi) ReportSummary(violations []rules.Violation) error {
+	var firstErr error
+	for _, r := range m {
+		if err := r.ReportSummary(violations); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
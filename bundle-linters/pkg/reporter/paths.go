@@ -0,0 +1,63 @@
+package reporter
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// PathOptions rewrites reported file paths, e.g. to make them relative to
+// a repository root so CI annotation systems can match them to files
+type PathOptions struct {
+	// StripPrefix is removed from the start of file paths that begin with
+	// it. A relative prefix also matches absolute paths under the working
+	// directory.
+	StripPrefix string
+
+	// AddPrefix is prepended to every file path, after stripping
+	AddPrefix string
+}
+
+// IsZero reports whether the options leave paths unchanged
+func (o PathOptions) IsZero() bool {
+	return o.StripPrefix == "" && o.AddPrefix == ""
+}
+
+// Apply rewrites a single file path
+func (o PathOptions) Apply(file string) string {
+	if file == "" || o.IsZero() {
+		return file
+	}
+
+	if o.StripPrefix != "" {
+		file = stripPathPrefix(file, o.StripPrefix)
+	}
+	if o.AddPrefix != "" {
+		file = path.Join(filepath.ToSlash(o.AddPrefix), file)
+	}
+	return file
+}
+
+// stripPathPrefix removes prefix from file when it covers whole path
+// segments, returning a slash-separated relative path
+func stripPathPrefix(file, prefix string) string {
+	candidates := []string{filepath.Clean(prefix)}
+	if filepath.IsAbs(file) && !filepath.IsAbs(prefix) {
+		if abs, err := filepath.Abs(prefix); err == nil {
+			candidates = append(candidates, abs)
+		}
+	}
+
+	for _, candidate := range candidates {
+		if candidate == "." {
+			continue
+		}
+		if file == candidate {
+			return "."
+		}
+		if rest, ok := strings.CutPrefix(file, strings.TrimSuffix(candidate, string(filepath.Separator))+string(filepath.Separator)); ok {
+			return filepath.ToSlash(rest)
+		}
+	}
+	return file
+}
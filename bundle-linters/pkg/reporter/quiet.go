@@ -0,0 +1,52 @@
+package reporter
+
+import (
+	"fmt"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// WithQuiet limits the report to violations that fail validation: errors,
+// and warnings in strict mode. The text format prints each on one line
+// and no summary is written.
+func (r *Reporter) WithQuiet(quiet bool) *Reporter {
+	r.quiet = quiet
+	return r
+}
+
+// fails reports whether a violation of the given severity fails
+// validation. It takes the linter's severity, before any severity map.
+func (r *Reporter) fails(severity rules.Severity) bool {
+	return severity == rules.SeverityError || (r.strict && severity == rules.SeverityWarning)
+}
+
+// failing returns the violations that fail validation
+func (r *Reporter) failing(violations []rules.Violation) []rules.Violation {
+	var kept []rules.Violation
+	for _, v := range violations {
+		if r.fails(v.Severity) {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+// reportQuiet writes one line per violation in the text format, e.g.
+// "❌ [ODH-OLM-004] bundle/manifests/pdb.yaml:6: message". Nothing is
+// written when there are none.
+func (r *Reporter) reportQuiet(violations []rules.Violation) error {
+	for _, v := range violations {
+		location := v.File
+		if location != "" && v.Line > 0 {
+			location = fmt.Sprintf("%s:%d", location, v.Line)
+		}
+		if location != "" {
+			location += ": "
+		}
+		line := fmt.Sprintf("%s [%s] %s%s", getSeverityIcon(v.Severity), v.RuleID, location, v.Message)
+		if _, err := fmt.Fprintln(r.writer, r.colorize(v.Severity, line)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
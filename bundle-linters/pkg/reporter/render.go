@@ -0,0 +1,86 @@
+package reporter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// SeverityMap remaps linter severities to the severities a downstream
+// system expects, e.g. warning -> error for a scanner that treats warnings
+// as high. Values use the vocabulary of the output format.
+type SeverityMap map[rules.Severity]string
+
+// ParseSeverityMap validates a severity mapping for an output format
+func ParseSeverityMap(format Format, mapping map[string]string) (SeverityMap, error) {
+	parsed := make(SeverityMap, len(mapping))
+	for from, to := range mapping {
+		if !isSeverity(from) {
+			return nil, fmt.Errorf("invalid source severity %q (expected error, warning or info)", from)
+		}
+		if !containsName(formatSeverities(format), to) {
+			return nil, fmt.Errorf("invalid %s severity %q (expected %s)", format, to, strings.Join(formatSeverities(format), ", "))
+		}
+		parsed[rules.Severity(from)] = to
+	}
+	return parsed, nil
+}
+
+// formatSeverities lists the severity names an output format can carry
+func formatSeverities(format Format) []string {
+	switch format {
+	case FormatSARIF:
+		return []string{"error", "warning", "note", "none"}
+	case FormatGitHub:
+		return []string{"error", "warning", "notice"}
+	}
+	return []string{"error", "warning", "info"}
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isSeverity(name string) bool {
+	switch rules.Severity(name) {
+	case rules.SeverityError, rules.SeverityWarning, rules.SeverityInfo:
+		return true
+	}
+	return false
+}
+
+// WithSeverityMap sets how severities are remapped when violations are
+// rendered. It only changes the labels that are written; counts, the
+// pass/fail outcome and exit codes follow the linter's severities.
+func (r *Reporter) WithSeverityMap(severities SeverityMap) *Reporter {
+	r.severities = severities
+	return r
+}
+
+// render returns a copy of violations prepared for output, with file
+// paths and severities rewritten
+func (r *Reporter) render(violations []rules.Violation) []rules.Violation {
+	if r.paths.IsZero() && len(r.severities) == 0 {
+		return violations
+	}
+	rendered := make([]rules.Violation, len(violations))
+	for i, v := range violations {
+		rendered[i] = r.renderViolation(v)
+	}
+	return rendered
+}
+
+// renderViolation prepares a single violation for output
+func (r *Reporter) renderViolation(v rules.Violation) rules.Violation {
+	v.File = r.paths.Apply(v.File)
+	if severity, ok := r.severities[v.Severity]; ok {
+		v.Severity = rules.Severity(severity)
+	}
+	return v
+}
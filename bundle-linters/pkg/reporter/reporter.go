@@ -9,33 +9,133 @@ import (
 	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
 )
 
+// Format selects how violations are rendered
+type Format string
+
+const (
+	FormatText   Format = "text"
+	FormatJSON   Format = "json"
+	FormatJSONL  Format = "jsonl"
+	FormatSARIF  Format = "sarif"
+	FormatGitHub Format = "github"
+	FormatJUnit  Format = "junit"
+)
+
+// ParseFormat validates an output format name
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(name); f {
+	case FormatText, FormatJSON, FormatJSONL, FormatSARIF, FormatGitHub, FormatJUnit:
+		return f, nil
+	}
+	return "", fmt.Errorf("unsupported output format: %s (expected text, json, jsonl, sarif, github or junit)", name)
+}
+
 // Reporter formats and outputs validation results
 type Reporter struct {
 	writer io.Writer
+	format Format
+	paths  PathOptions
+
+	severities SeverityMap
+	groupBy    GroupBy
+
+	// summary receives the human-readable summary for formats that do not
+	// carry one themselves (github)
+	summary io.Writer
+
+	// strict makes warnings fail validation like errors
+	strict bool
+
+	// skipped maps the rules that did not run to the reason (junit)
+	skipped map[string]string
+
+	// quiet limits the report to failing violations, one line each in the
+	// text format, and drops the summary
+	quiet bool
+
+	// colorEnabled colors severity lines in the text format with ANSI
+	// escapes; set at construction when the writer is a terminal
+	colorEnabled bool
+
+	// document collects the results of document formats across bundles
+	// instead of writing them; bundlePath names this reporter's bundle
+	document   *Document
+	bundlePath string
 }
 
-// New creates a new Reporter
+// New creates a new Reporter using the text format
 func New(writer io.Writer) *Reporter {
-	return &Reporter{writer: writer}
+	return NewWithFormat(writer, FormatText)
+}
+
+// NewWithFormat creates a new Reporter using the given output format
+func NewWithFormat(writer io.Writer, format Format) *Reporter {
+	return &Reporter{writer: writer, format: format, colorEnabled: colorSupported(writer)}
+}
+
+// WithPaths sets how file paths are rewritten when violations are rendered
+func (r *Reporter) WithPaths(paths PathOptions) *Reporter {
+	r.paths = paths
+	return r
+}
+
+// WithSummaryWriter sets where formats without a summary of their own
+// (github) write the human-readable summary line
+func (r *Reporter) WithSummaryWriter(summary io.Writer) *Reporter {
+	r.summary = summary
+	return r
+}
+
+// WithStrict sets whether warnings fail validation like errors
+func (r *Reporter) WithStrict(strict bool) *Reporter {
+	r.strict = strict
+	return r
+}
+
+// IsMachineReadable reports whether the output is meant for tools rather
+// than humans, in which case progress messages belong on stderr
+func (r *Reporter) IsMachineReadable() bool {
+	return r.format != FormatText
 }
 
 // Report outputs validation violations
 func (r *Reporter) Report(violations []rules.Violation) error {
-	if len(violations) == 0 {
-		_, err := fmt.Fprintln(r.writer, "✓ No issues found")
-		return err
+	// Whether a violation fails is decided on the linter's severities; the
+	// severity map only changes the labels that are written
+	if r.quiet {
+		violations = r.failing(violations)
+	}
+	rendered := r.render(violations)
+	if r.quiet && r.format == FormatText {
+		return r.reportQuiet(rendered)
 	}
 
-	// Sort violations by severity, then by file, then by rule ID
-	sort.Slice(violations, func(i, j int) bool {
-		if violations[i].Severity != violations[j].Severity {
-			return severityWeight(violations[i].Severity) > severityWeight(violations[j].Severity)
+	if r.format.IsDocument() {
+		bundle := bundleReport{violations: violations, rendered: rendered, skipped: r.skipped}
+		if r.document == nil {
+			return r.writeDocument([]bundleReport{bundle})
 		}
-		if violations[i].File != violations[j].File {
-			return violations[i].File < violations[j].File
+		bundle.path = r.paths.Apply(r.bundlePath)
+		r.document.bundles = append(r.document.bundles, bundle)
+		return nil
+	}
+
+	switch r.format {
+	case FormatGitHub:
+		return r.reportGitHub(rendered)
+	case FormatJSONL:
+		for _, v := range rendered {
+			if err := r.writeViolationLine(v); err != nil {
+				return err
+			}
 		}
-		return violations[i].RuleID < violations[j].RuleID
-	})
+		return nil
+	}
+
+	if len(violations) == 0 {
+		_, err := fmt.Fprintln(r.writer, r.paint(ansiGreen, "✓ No issues found"))
+		return err
+	}
 
 	// Count by severity
 	errorCount := 0
@@ -53,6 +153,18 @@ func (r *Reporter) Report(violations []rules.Violation) error {
 		}
 	}
 
+	// Sort violations by severity, then by file, then by rule ID
+	violations = rendered
+	sort.Slice(violations, func(i, j int) bool {
+		if violations[i].Severity != violations[j].Severity {
+			return severityWeight(violations[i].Severity) > severityWeight(violations[j].Severity)
+		}
+		if violations[i].File != violations[j].File {
+			return violations[i].File < violations[j].File
+		}
+		return violations[i].RuleID < violations[j].RuleID
+	})
+
 	// Print summary header
 	fmt.Fprintf(r.writer, "\nFound %d issue(s):\n", len(violations))
 	if errorCount > 0 {
@@ -66,6 +178,11 @@ func (r *Reporter) Report(violations []rules.Violation) error {
 	}
 	fmt.Fprintln(r.writer, "")
 
+	if r.groupBy == GroupByRule {
+		r.writeGroupedByRule(violations)
+		return nil
+	}
+
 	// Print violations
 	for _, v := range violations {
 		fmt.Fprintln(r.writer, r.formatViolation(v))
@@ -81,7 +198,7 @@ func (r *Reporter) formatViolation(v rules.Violation) string {
 
 	// Format header with severity emoji
 	severityIcon := getSeverityIcon(v.Severity)
-	fmt.Fprintf(&sb, "%s [%s] %s\n", severityIcon, v.RuleID, v.Message)
+	fmt.Fprintf(&sb, "%s\n", r.colorize(v.Severity, fmt.Sprintf("%s [%s] %s", severityIcon, v.RuleID, v.Message)))
 
 	// Add file location
 	if v.File != "" {
@@ -136,7 +253,9 @@ func severityWeight(severity rules.Severity) int {
 	}
 }
 
-// ReportSummary outputs a summary of violations
+// ReportSummary outputs a summary of violations. Counts and the outcome
+// use the linter's severities, not those of the severity map, so the
+// summary agrees with the exit code.
 func (r *Reporter) ReportSummary(violations []rules.Violation) error {
 	errorCount := 0
 	warningCount := 0
@@ -150,15 +269,54 @@ func (r *Reporter) ReportSummary(violations []rules.Violation) error {
 		}
 	}
 
+	switch r.format {
+	case FormatJSON, FormatSARIF, FormatJUnit:
+		// The summary is part of the document written by Report
+	case FormatJSONL:
+		if err := r.writeJSONLine(jsonSummaryLine{Type: "summary", jsonSummary: summarize(violations, r.strict)}); err != nil {
+			return err
+		}
+	}
+
+	out := r.writer
+	if r.format == FormatGitHub && r.summary != nil {
+		out = r.summary
+	} else if r.IsMachineReadable() {
+		if errorCount > 0 {
+			return fmt.Errorf("validation failed with %d error(s)", errorCount)
+		}
+		if r.strict && warningCount > 0 {
+			return fmt.Errorf("validation failed with %d warning(s) in strict mode", warningCount)
+		}
+		return nil
+	}
+
+	if r.quiet {
+		// The outcome is still returned, just not printed
+		out = io.Discard
+	}
+	// Color only applies to the writer it was detected for
+	paint := func(color, text string) string {
+		if out != r.writer {
+			return text
+		}
+		return r.paint(color, text)
+	}
+
 	if errorCount > 0 {
-		fmt.Fprintf(r.writer, "\n❌ Validation failed: %d error(s), %d warning(s)\n", errorCount, warningCount)
+		fmt.Fprintf(out, "\n%s\n", paint(ansiRed, fmt.Sprintf("❌ Validation failed: %d error(s), %d warning(s)", errorCount, warningCount)))
 		return fmt.Errorf("validation failed with %d error(s)", errorCount)
 	}
 
+	if r.strict && warningCount > 0 {
+		fmt.Fprintf(out, "\n%s\n", paint(ansiRed, fmt.Sprintf("❌ Validation failed in strict mode: %d warning(s)", warningCount)))
+		return fmt.Errorf("validation failed with %d warning(s) in strict mode", warningCount)
+	}
+
 	if warningCount > 0 {
-		fmt.Fprintf(r.writer, "\n⚠️  Validation passed with %d warning(s)\n", warningCount)
+		fmt.Fprintf(out, "\n%s\n", paint(ansiYellow, fmt.Sprintf("⚠️  Validation passed with %d warning(s)", warningCount)))
 	} else {
-		fmt.Fprintln(r.writer, "\n✓ All checks passed!")
+		fmt.Fprintf(out, "\n%s\n", paint(ansiGreen, "✓ All checks passed!"))
 	}
 
 	return nil
@@ -0,0 +1,96 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func warnings(n int) []rules.Violation {
+	violations := make([]rules.Violation, n)
+	for i := range violations {
+		violations[i] = rules.Violation{
+			RuleID:   "ODH-OLM-004",
+			RuleName: "pdb-maxunavailable-zero",
+			Category: rules.CategoryUpgrade,
+			Severity: rules.SeverityWarning,
+			Message:  "warning",
+			File:     "bundle/manifests/pdb.yaml",
+		}
+	}
+	return violations
+}
+
+func TestReportSummarySeverityMapOnlyChangesLabels(t *testing.T) {
+	violations := warnings(7)
+	severities, err := ParseSeverityMap(FormatText, map[string]string{"warning": "error"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	r := New(&out).WithSeverityMap(severities)
+	if err := r.Report(violations); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.ReportSummary(violations); err != nil {
+		t.Errorf("ReportSummary() = %v, want nil: mapped warnings must not fail the run", err)
+	}
+
+	got := out.String()
+	if !strings.Contains(got, "❌ [ODH-OLM-004]") {
+		t.Errorf("violations are not labelled with the mapped severity:\n%s", got)
+	}
+	if !strings.Contains(got, "Validation passed with 7 warning(s)") {
+		t.Errorf("summary does not count the original severities:\n%s", got)
+	}
+	if strings.Contains(got, "Validation failed") {
+		t.Errorf("summary reports a failure the exit code does not:\n%s", got)
+	}
+	if violations[0].Severity != rules.SeverityWarning {
+		t.Errorf("Report() rewrote the caller's violations: severity = %s", violations[0].Severity)
+	}
+}
+
+func TestReportJSONSeverityMapKeepsSummary(t *testing.T) {
+	severities, err := ParseSeverityMap(FormatJSON, map[string]string{"warning": "error"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := NewWithFormat(&out, FormatJSON).WithSeverityMap(severities).Report(warnings(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	var report jsonReport
+	if err := json.Unmarshal(out.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if got := report.Violations[0].Severity; got != "error" {
+		t.Errorf("violation severity = %q, want the mapped %q", got, "error")
+	}
+	want := jsonSummary{Total: 2, Warnings: 2, Passed: true}
+	if report.Summary != want {
+		t.Errorf("summary = %+v, want %+v", report.Summary, want)
+	}
+}
+
+func TestReportSummaryStrictFailsOnOriginalWarnings(t *testing.T) {
+	severities, err := ParseSeverityMap(FormatText, map[string]string{"warning": "info"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	err = New(&out).WithSeverityMap(severities).WithStrict(true).ReportSummary(warnings(1))
+	if err == nil {
+		t.Fatalf("ReportSummary() = nil, want a strict-mode failure:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "Validation failed in strict mode: 1 warning(s)") {
+		t.Errorf("unexpected summary:\n%s", out.String())
+	}
+}
@@ -0,0 +1,191 @@
+package reporter
+
+import (
+	"encoding/json"
+	"net/url"
+	"path/filepath"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+const (
+	sarifVersion   = "2.1.0"
+	sarifSchemaURI = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifToolName  = "odhlint-bundle"
+	sarifToolURI   = "https://github.com/opendatahub-io/odh-linter"
+)
+
+// sarifLevels maps severities to SARIF result levels. Besides linter
+// severities it accepts SARIF's own level names, which a severityMap for
+// the sarif format may produce.
+var sarifLevels = map[rules.Severity]string{
+	rules.SeverityError:   "error",
+	rules.SeverityWarning: "warning",
+	rules.SeverityInfo:    "note",
+	"note":                "note",
+	"none":                "none",
+}
+
+// The types below cover the subset of the SARIF 2.1.0 object model the
+// linter produces
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string               `json:"name"`
+	InformationURI string               `json:"informationUri"`
+	Rules          []sarifReportingRule `json:"rules"`
+}
+
+type sarifReportingRule struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	FullDescription      sarifMessage       `json:"fullDescription"`
+	Help                 *sarifMessage      `json:"help,omitempty"`
+	HelpURI              string             `json:"helpUri,omitempty"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+	Properties           sarifProperties    `json:"properties"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifProperties struct {
+	Tags []string `json:"tags"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// reportSARIF writes violations as a SARIF log with a single run, which
+// holds the results of every bundle. Every registered rule is listed in
+// the run's driver, so code scanning can show rule help even for rules that
+// reported nothing.
+func (r *Reporter) reportSARIF(bundles []bundleReport) error {
+	var violations []rules.Violation
+	for _, bundle := range bundles {
+		violations = append(violations, bundle.rendered...)
+	}
+
+	allRules := rules.GetAllRules()
+	driver := sarifDriver{
+		Name:           sarifToolName,
+		InformationURI: sarifToolURI,
+		Rules:          make([]sarifReportingRule, 0, len(allRules)),
+	}
+	ruleIndex := make(map[string]int, len(allRules))
+	for i, rule := range allRules {
+		ruleIndex[rule.ID()] = i
+		driver.Rules = append(driver.Rules, toSARIFRule(rule))
+	}
+
+	results := make([]sarifResult, 0, len(violations))
+	for _, v := range violations {
+		result := sarifResult{
+			RuleID:    v.RuleID,
+			RuleIndex: -1,
+			Level:     sarifLevel(v.Severity),
+			Message:   sarifMessage{Text: v.Message},
+		}
+		if i, ok := ruleIndex[v.RuleID]; ok {
+			result.RuleIndex = i
+		}
+		if v.File != "" {
+			location := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: sarifURI(v.File)}}
+			if v.Line > 0 {
+				location.Region = &sarifRegion{StartLine: v.Line}
+			}
+			result.Locations = []sarifLocation{{PhysicalLocation: location}}
+		}
+		results = append(results, result)
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchemaURI,
+		Version: sarifVersion,
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+
+	encoder := json.NewEncoder(r.writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(log)
+}
+
+// toSARIFRule describes a rule as a SARIF reporting descriptor
+func toSARIFRule(rule rules.Rule) sarifReportingRule {
+	descriptor := sarifReportingRule{
+		ID:                   rule.ID(),
+		Name:                 rule.Name(),
+		ShortDescription:     sarifMessage{Text: rule.Name()},
+		FullDescription:      sarifMessage{Text: rule.Description()},
+		DefaultConfiguration: sarifConfiguration{Level: sarifLevel(rule.Severity())},
+		Properties:           sarifProperties{Tags: []string{string(rule.Category())}},
+	}
+	if explainer, ok := rule.(rules.Explainer); ok {
+		explanation := explainer.Explain()
+		if explanation.Remediation != "" {
+			descriptor.Help = &sarifMessage{Text: explanation.Remediation}
+		}
+		descriptor.HelpURI = explanation.DocsURL
+	}
+	return descriptor
+}
+
+// sarifLevel converts a severity to a SARIF level, defaulting to warning
+func sarifLevel(severity rules.Severity) string {
+	if level, ok := sarifLevels[severity]; ok {
+		return level
+	}
+	return "warning"
+}
+
+// sarifURI converts a file path to a URI reference. Relative paths stay
+// relative so code scanning resolves them against the repository root.
+func sarifURI(path string) string {
+	u := url.URL{Path: filepath.ToSlash(path)}
+	if filepath.IsAbs(path) {
+		u.Scheme = "file"
+	}
+	return u.String()
+}
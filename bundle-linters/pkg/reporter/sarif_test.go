@@ -0,0 +1,139 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/schema"
+)
+
+func bundleViolations(file string) []rules.Violation {
+	return []rules.Violation{
+		{
+			RuleID:   "ODH-OLM-004",
+			RuleName: "pdb-maxunavailable-zero",
+			Category: rules.CategoryUpgrade,
+			Severity: rules.SeverityError,
+			Message:  "PodDisruptionBudget 'my-pdb' has maxUnavailable set to 0",
+			File:     file,
+			Line:     6,
+		},
+		{
+			RuleID:   "ODH-OLM-022",
+			RuleName: "crd-missing-categories",
+			Category: rules.CategoryOLMBestPractice,
+			Severity: rules.SeverityInfo,
+			Message:  "CRD 'widgets.example.com' has no categories",
+		},
+	}
+}
+
+// checkSARIF validates a log against the official SARIF 2.1.0 schema,
+// checks the cross-references the schema cannot express, and returns the
+// log's runs
+func checkSARIF(t *testing.T, data []byte) []map[string]interface{} {
+	t.Helper()
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	var log map[string]interface{}
+	if err := decoder.Decode(&log); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, data)
+	}
+	if decoder.More() {
+		t.Fatalf("output holds more than one JSON document:\n%s", data)
+	}
+
+	sarifSchema, err := schema.Load(filepath.Join("testdata", "sarif-schema-2.1.0.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, validationErr := range sarifSchema.Validate(log) {
+		t.Errorf("SARIF schema: %v", validationErr)
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+
+	if log["$schema"] != sarifSchemaURI {
+		t.Errorf("$schema = %v, want %s", log["$schema"], sarifSchemaURI)
+	}
+
+	var runs []map[string]interface{}
+	for i, rawRun := range log["runs"].([]interface{}) {
+		run := rawRun.(map[string]interface{})
+		runs = append(runs, run)
+
+		driver := run["tool"].(map[string]interface{})["driver"].(map[string]interface{})
+		if driver["name"] != sarifToolName {
+			t.Errorf("runs[%d].tool.driver.name = %v, want %s", i, driver["name"], sarifToolName)
+		}
+		descriptors, _ := driver["rules"].([]interface{})
+
+		results, _ := run["results"].([]interface{})
+		for j, rawResult := range results {
+			result := rawResult.(map[string]interface{})
+			index := int(result["ruleIndex"].(float64))
+			if index < 0 || index >= len(descriptors) {
+				t.Errorf("runs[%d].results[%d].ruleIndex = %d, out of range", i, j, index)
+			} else if id := descriptors[index].(map[string]interface{})["id"]; id != result["ruleId"] {
+				t.Errorf("runs[%d].results[%d].ruleIndex points at %v, want %v", i, j, id, result["ruleId"])
+			}
+		}
+	}
+	return runs
+}
+
+func TestReportSARIF(t *testing.T) {
+	var out bytes.Buffer
+	if err := NewWithFormat(&out, FormatSARIF).Report(bundleViolations("bundle/manifests/pdb.yaml")); err != nil {
+		t.Fatal(err)
+	}
+
+	runs := checkSARIF(t, out.Bytes())
+	if len(runs) != 1 {
+		t.Fatalf("got %d runs, want 1", len(runs))
+	}
+	results := runs[0]["results"].([]interface{})
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	region := results[0].(map[string]interface{})["locations"].([]interface{})[0].(map[string]interface{})["physicalLocation"].(map[string]interface{})["region"].(map[string]interface{})
+	if region["startLine"] != float64(6) {
+		t.Errorf("startLine = %v, want 6", region["startLine"])
+	}
+}
+
+func TestReportSARIFSeveralBundles(t *testing.T) {
+	doc := NewDocument()
+	for _, bundle := range []string{"bundles/a", "bundles/b"} {
+		rep := NewWithFormat(&bytes.Buffer{}, FormatSARIF).WithDocument(doc, bundle)
+		if err := rep.Report(bundleViolations(bundle + "/manifests/pdb.yaml")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := NewWithFormat(&out, FormatSARIF).WriteDocument(doc); err != nil {
+		t.Fatal(err)
+	}
+
+	runs := checkSARIF(t, out.Bytes())
+	if len(runs) != 1 {
+		t.Fatalf("got %d runs, want the bundles merged into 1", len(runs))
+	}
+	var uris []string
+	for _, result := range runs[0]["results"].([]interface{}) {
+		locations, _ := result.(map[string]interface{})["locations"].([]interface{})
+		for _, location := range locations {
+			physical := location.(map[string]interface{})["physicalLocation"].(map[string]interface{})
+			uris = append(uris, physical["artifactLocation"].(map[string]interface{})["uri"].(string))
+		}
+	}
+	want := []string{"bundles/a/manifests/pdb.yaml", "bundles/b/manifests/pdb.yaml"}
+	if len(uris) != len(want) || uris[0] != want[0] || uris[1] != want[1] {
+		t.Errorf("result uris = %v, want %v", uris, want)
+	}
+}
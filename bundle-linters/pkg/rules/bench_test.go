@@ -0,0 +1,77 @@
+package rules_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/bench"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/loader"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+// benchSizes are the manifest counts of the synthetic bundles benchmarked
+var benchSizes = []int{10, 100, 1000}
+
+// generateBundle writes a synthetic bundle of the given size to a
+// temporary directory
+func generateBundle(b *testing.B, manifests int) string {
+	b.Helper()
+	dir := b.TempDir()
+	if err := bench.Generate(dir, manifests); err != nil {
+		b.Fatal(err)
+	}
+	return dir
+}
+
+// BenchmarkLoadBundle parses a bundle from disk
+func BenchmarkLoadBundle(b *testing.B) {
+	for _, size := range benchSizes {
+		b.Run(fmt.Sprintf("manifests=%d", size), func(b *testing.B) {
+			dir := generateBundle(b, size)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := loader.LoadBundle(dir); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkValidateBundle runs every rule on a loaded bundle
+func BenchmarkValidateBundle(b *testing.B) {
+	for _, size := range benchSizes {
+		b.Run(fmt.Sprintf("manifests=%d", size), func(b *testing.B) {
+			bundle, err := loader.LoadBundle(generateBundle(b, size))
+			if err != nil {
+				b.Fatal(err)
+			}
+			allRules := rules.GetAllRules()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				rules.ValidateBundle(bundle, allRules)
+			}
+		})
+	}
+}
+
+// BenchmarkLintBundle loads and validates a bundle
+func BenchmarkLintBundle(b *testing.B) {
+	for _, size := range benchSizes {
+		b.Run(fmt.Sprintf("manifests=%d", size), func(b *testing.B) {
+			dir := generateBundle(b, size)
+			allRules := rules.GetAllRules()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				bundle, err := loader.LoadBundle(dir)
+				if err != nil {
+					b.Fatal(err)
+				}
+				rules.ValidateBundle(bundle, allRules)
+			}
+		})
+	}
+}
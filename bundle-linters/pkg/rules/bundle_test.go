@@ -0,0 +1,154 @@
+package rules_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/loader"
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+const testAnnotations = `annotations:
+  operators.operatorframework.io.bundle.mediatype.v1: registry+v1
+  operators.operatorframework.io.bundle.manifests.v1: manifests/
+  operators.operatorframework.io.bundle.metadata.v1: metadata/
+  operators.operatorframework.io.bundle.package.v1: example-operator
+  operators.operatorframework.io.bundle.channels.v1: stable
+  operators.operatorframework.io.bundle.channel.default.v1: stable
+`
+
+// loadBundle writes files, keyed by their path in the bundle, to a
+// temporary bundle directory and loads it. metadata/annotations.yaml is
+// added unless files provides one.
+func loadBundle(t *testing.T, files map[string]string) *rules.Bundle {
+	t.Helper()
+
+	dir := t.TempDir()
+	if _, ok := files["metadata/annotations.yaml"]; !ok {
+		files["metadata/annotations.yaml"] = testAnnotations
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "manifests"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bundle, err := loader.LoadBundle(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bundle
+}
+
+// indent prefixes every non-empty line of text with n spaces
+func indent(text string, n int) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = strings.Repeat(" ", n) + line
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// csvWithSpec returns a CSV manifest named example-operator.v1.0.0 with the
+// given spec
+func csvWithSpec(spec string) string {
+	return `apiVersion: operators.coreos.com/v1alpha1
+kind: ClusterServiceVersion
+metadata:
+  name: example-operator.v1.0.0
+spec:
+` + indent(spec, 2)
+}
+
+// csvWithPod returns a CSV whose single install deployment,
+// example-operator, has the given pod spec and replica count. Its pods are
+// labelled app: example-operator.
+func csvWithPod(replicas, podSpec string) string {
+	return csvWithSpec(`version: 1.0.0
+install:
+  strategy: deployment
+  spec:
+    deployments:
+    - name: example-operator
+      spec:
+        replicas: ` + replicas + `
+        selector:
+          matchLabels:
+            app: example-operator
+        template:
+          metadata:
+            labels:
+              app: example-operator
+          spec:
+` + indent(podSpec, 12))
+}
+
+// withAnnotations adds metadata.annotations to a manifest written by
+// csvWithSpec or csvWithPod
+func withAnnotations(csv, annotations string) string {
+	return strings.Replace(csv, "  name: example-operator.v1.0.0\n",
+		"  name: example-operator.v1.0.0\n  annotations:\n"+indent(annotations, 4), 1)
+}
+
+// ruleTest is a bundle and the messages a rule should report for it
+type ruleTest struct {
+	name  string
+	files map[string]string
+	want  []string // Substrings of the expected messages, in order
+}
+
+// validate runs a rule on a bundle the way the linter does: a rule that
+// skips the bundle reports nothing
+func validate(rule rules.Rule, bundle *rules.Bundle) []rules.Violation {
+	if skipper, ok := rule.(rules.Skipper); ok && skipper.SkipReason(bundle) != "" {
+		return nil
+	}
+	return rule.Validate(bundle)
+}
+
+// runRuleTests checks the violations rule reports for each test's bundle
+func runRuleTests(t *testing.T, rule rules.Rule, tests []ruleTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := validate(rule, loadBundle(t, tt.files))
+			checkMessages(t, violations, tt.want)
+			for _, v := range violations {
+				if v.RuleID != rule.ID() || v.File == "" {
+					t.Errorf("violation %q has rule %s and file %q", v.Message, v.RuleID, v.File)
+				}
+			}
+		})
+	}
+}
+
+// checkMessages fails the test unless each violation's message contains
+// the corresponding entry of want
+func checkMessages(t *testing.T, violations []rules.Violation, want []string) {
+	t.Helper()
+
+	if len(violations) != len(want) {
+		var messages []string
+		for _, v := range violations {
+			messages = append(messages, v.Message)
+		}
+		t.Fatalf("got %d violation(s), want %d:\n%s", len(violations), len(want), strings.Join(messages, "\n"))
+	}
+	for i, v := range violations {
+		if !strings.Contains(v.Message, want[i]) {
+			t.Errorf("violation %d = %q, want it to contain %q", i, v.Message, want[i])
+		}
+	}
+}
@@ -28,6 +28,26 @@ func (r *MinKubeVersionRule) Fixable() bool {
 	return false // Requires user to determine minimum version
 }
 
+func (r *MinKubeVersionRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set spec.minKubeVersion in the ClusterServiceVersion to the oldest Kubernetes version the operator is tested against.",
+		BadExample: `spec:
+  displayName: My Operator
+  # minKubeVersion not set`,
+		GoodExample: `spec:
+  displayName: My Operator
+  minKubeVersion: 1.25.0`,
+		DocsURL: "https://olm.operatorframework.io/docs/concepts/crds/clusterserviceversion/",
+	}
+}
+
+func (r *MinKubeVersionRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	return ""
+}
+
 func (r *MinKubeVersionRule) Validate(bundle *Bundle) []Violation {
 	var violations []Violation
 
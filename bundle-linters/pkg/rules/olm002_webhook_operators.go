@@ -33,6 +33,33 @@ func (r *WebhookOperatorResourcesRule) Fixable() bool {
 	return false
 }
 
+func (r *WebhookOperatorResourcesRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Narrow the webhook rules to the operator's own API groups and resources. Never match '*' groups, operators.coreos.com, or webhook configuration resources.",
+		BadExample: `webhookdefinitions:
+- type: ValidatingAdmissionWebhook
+  rules:
+  - apiGroups: ["operators.coreos.com"]
+    resources: ["*"]`,
+		GoodExample: `webhookdefinitions:
+- type: ValidatingAdmissionWebhook
+  rules:
+  - apiGroups: ["myapp.example.com"]
+    resources: ["myresources"]`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/adding-admission-and-conversion-webhooks/",
+	}
+}
+
+func (r *WebhookOperatorResourcesRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if !csvHasWebhookType(bundle.CSV, "ValidatingAdmissionWebhook") && !csvHasWebhookType(bundle.CSV, "MutatingAdmissionWebhook") {
+		return "the CSV defines no admission webhooks"
+	}
+	return ""
+}
+
 func (r *WebhookOperatorResourcesRule) Validate(bundle *Bundle) []Violation {
 	var violations []Violation
 
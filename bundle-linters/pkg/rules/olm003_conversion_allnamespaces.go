@@ -1,6 +1,10 @@
 package rules
 
-import "fmt"
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
 
 // ODH-OLM-003: Conversion Webhook Without AllNamespaces Install Mode
 
@@ -30,6 +34,76 @@ func (r *ConversionWebhookAllNamespacesRule) Fixable() bool {
 	return true // Can be auto-fixed by setting AllNamespaces to true
 }
 
+func (r *ConversionWebhookAllNamespacesRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Mark the AllNamespaces install mode as supported and the other install modes as unsupported.",
+		BadExample: `installModes:
+- type: OwnNamespace
+  supported: true
+- type: AllNamespaces
+  supported: false`,
+		GoodExample: `installModes:
+- type: OwnNamespace
+  supported: false
+- type: AllNamespaces
+  supported: true`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/adding-admission-and-conversion-webhooks/",
+	}
+}
+
+// Fix marks the AllNamespaces install mode as supported, adding it when
+// the CSV does not list it
+func (r *ConversionWebhookAllNamespacesRule) Fix(doc *yaml.Node, violation Violation) (bool, error) {
+	if documentKind(doc) != "ClusterServiceVersion" {
+		return false, nil
+	}
+
+	spec := mappingValue(documentRoot(doc), "spec")
+	if spec == nil || spec.Kind != yaml.MappingNode {
+		return false, fmt.Errorf("CSV has no spec")
+	}
+
+	modes := mappingValue(spec, "installModes")
+	if modes == nil {
+		modes = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		spec.Content = append(spec.Content, newStringNode("installModes"), modes)
+	}
+	if modes.Kind != yaml.SequenceNode {
+		return false, fmt.Errorf("spec.installModes is not a list")
+	}
+
+	for _, mode := range modes.Content {
+		if modeType := mappingValue(mode, "type"); modeType == nil || modeType.Value != "AllNamespaces" {
+			continue
+		}
+		if supported := mappingValue(mode, "supported"); supported != nil {
+			return setBoolValue(supported, true), nil
+		}
+		mode.Content = append(mode.Content, newStringNode("supported"), newBoolNode(true))
+		return true, nil
+	}
+
+	modes.Content = append(modes.Content, &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  "!!map",
+		Content: []*yaml.Node{
+			newStringNode("type"), newStringNode("AllNamespaces"),
+			newStringNode("supported"), newBoolNode(true),
+		},
+	})
+	return true, nil
+}
+
+func (r *ConversionWebhookAllNamespacesRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if !csvHasWebhookType(bundle.CSV, "ConversionWebhook") {
+		return "the CSV defines no conversion webhook"
+	}
+	return ""
+}
+
 func (r *ConversionWebhookAllNamespacesRule) Validate(bundle *Bundle) []Violation {
 	var violations []Violation
 
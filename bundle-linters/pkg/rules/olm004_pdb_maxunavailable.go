@@ -33,6 +33,26 @@ func (r *PDBMaxUnavailableRule) Fixable() bool {
 	return false
 }
 
+func (r *PDBMaxUnavailableRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set maxUnavailable to at least 1 (or a non-zero percentage) so nodes can still be drained.",
+		BadExample: `kind: PodDisruptionBudget
+spec:
+  maxUnavailable: 0`,
+		GoodExample: `kind: PodDisruptionBudget
+spec:
+  maxUnavailable: 1`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/ship-operator-supporting-resources/",
+	}
+}
+
+func (r *PDBMaxUnavailableRule) SkipReason(bundle *Bundle) string {
+	if !hasResourceKind(bundle, "PodDisruptionBudget") {
+		return "the bundle has no PodDisruptionBudget"
+	}
+	return ""
+}
+
 func (r *PDBMaxUnavailableRule) Validate(bundle *Bundle) []Violation {
 	var violations []Violation
 
@@ -51,6 +71,7 @@ func (r *PDBMaxUnavailableRule) Validate(bundle *Bundle) []Violation {
 					Severity:    r.Severity(),
 					Message:     fmt.Sprintf("PodDisruptionBudget '%s' has maxUnavailable set to 0 or 0%%", resource.Metadata.Name),
 					File:        resource.FilePath,
+					Line:        lineOf(resource.Node, "spec", "maxUnavailable"),
 					Description: "Setting maxUnavailable to 0 or 0% prevents node drains and can block cluster lifecycle operations. Use a value >= 1.",
 					Fixable:     r.Fixable(),
 				})
@@ -33,6 +33,26 @@ func (r *PDBMinAvailableRule) Fixable() bool {
 	return false
 }
 
+func (r *PDBMinAvailableRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Lower minAvailable below 100% (or use maxUnavailable) so at least one pod can be evicted during a drain.",
+		BadExample: `kind: PodDisruptionBudget
+spec:
+  minAvailable: 100%`,
+		GoodExample: `kind: PodDisruptionBudget
+spec:
+  minAvailable: 50%`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/ship-operator-supporting-resources/",
+	}
+}
+
+func (r *PDBMinAvailableRule) SkipReason(bundle *Bundle) string {
+	if !hasResourceKind(bundle, "PodDisruptionBudget") {
+		return "the bundle has no PodDisruptionBudget"
+	}
+	return ""
+}
+
 func (r *PDBMinAvailableRule) Validate(bundle *Bundle) []Violation {
 	var violations []Violation
 
@@ -51,6 +71,7 @@ func (r *PDBMinAvailableRule) Validate(bundle *Bundle) []Violation {
 					Severity:    r.Severity(),
 					Message:     fmt.Sprintf("PodDisruptionBudget '%s' has minAvailable set to 100%%", resource.Metadata.Name),
 					File:        resource.FilePath,
+					Line:        lineOf(resource.Node, "spec", "minAvailable"),
 					Description: "Setting minAvailable to 100% prevents node drains and can block cluster lifecycle operations. Use a lower percentage.",
 					Fixable:     r.Fixable(),
 				})
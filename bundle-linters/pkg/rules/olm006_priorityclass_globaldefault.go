@@ -1,6 +1,10 @@
 package rules
 
-import "fmt"
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
 
 // ODH-OLM-006: PriorityClass with globalDefault=true
 
@@ -30,6 +34,42 @@ func (r *PriorityClassGlobalDefaultRule) Fixable() bool {
 	return true // Can be auto-fixed by setting to false
 }
 
+func (r *PriorityClassGlobalDefaultRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set globalDefault to false and reference the PriorityClass explicitly from the operator's pod spec.",
+		BadExample: `kind: PriorityClass
+value: 1000
+globalDefault: true`,
+		GoodExample: `kind: PriorityClass
+value: 1000
+globalDefault: false`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/ship-operator-supporting-resources/",
+	}
+}
+
+// Fix sets globalDefault to false on the flagged PriorityClass
+func (r *PriorityClassGlobalDefaultRule) Fix(doc *yaml.Node, violation Violation) (bool, error) {
+	if documentKind(doc) != "PriorityClass" {
+		return false, nil
+	}
+
+	changed := false
+	root := documentRoot(doc)
+	for _, node := range []*yaml.Node{mappingValue(root, "globalDefault"), mappingPath(root, "spec", "globalDefault")} {
+		if node != nil && node.Kind == yaml.ScalarNode && isTrueValue(node.Value) {
+			changed = setBoolValue(node, false) || changed
+		}
+	}
+	return changed, nil
+}
+
+func (r *PriorityClassGlobalDefaultRule) SkipReason(bundle *Bundle) string {
+	if !hasResourceKind(bundle, "PriorityClass") {
+		return "the bundle has no PriorityClass"
+	}
+	return ""
+}
+
 func (r *PriorityClassGlobalDefaultRule) Validate(bundle *Bundle) []Violation {
 	var violations []Violation
 
@@ -48,6 +88,7 @@ func (r *PriorityClassGlobalDefaultRule) Validate(bundle *Bundle) []Violation {
 					Severity:    r.Severity(),
 					Message:     fmt.Sprintf("PriorityClass '%s' has globalDefault set to true", resource.Metadata.Name),
 					File:        resource.FilePath,
+					Line:        lineOf(resource.Node, "spec", "globalDefault"),
 					Description: "PriorityClass globalDefault should be false in operator bundles. Setting it to true affects all pods cluster-wide.",
 					Fixable:     r.Fixable(),
 				})
@@ -33,6 +33,27 @@ func (r *ChannelNamingRule) Fixable() bool {
 	return false
 }
 
+func (r *ChannelNamingRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Rename channels to start with a recommended stability prefix such as stable, fast or candidate.",
+		BadExample: `annotations:
+  operators.operatorframework.io.bundle.channels.v1: myapp-v2`,
+		GoodExample: `annotations:
+  operators.operatorframework.io.bundle.channels.v1: stable-v2,fast-v2`,
+		DocsURL: "https://olm.operatorframework.io/docs/best-practices/channel-naming/",
+	}
+}
+
+func (r *ChannelNamingRule) SkipReason(bundle *Bundle) string {
+	if bundle.Annotations == nil {
+		return skipNoAnnotations
+	}
+	if len(bundle.Annotations.Channels) == 0 {
+		return "the bundle annotations list no channels"
+	}
+	return ""
+}
+
 func (r *ChannelNamingRule) Validate(bundle *Bundle) []Violation {
 	var violations []Violation
 
@@ -1,6 +1,10 @@
 package rules
 
-import "fmt"
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
 
 // ODH-OLM-010: Conversion Webhook CRD with PreserveUnknownFields=true
 
@@ -30,6 +34,42 @@ func (r *ConversionPreserveUnknownFieldsRule) Fixable() bool {
 	return true // Can be auto-fixed by setting to false
 }
 
+func (r *ConversionPreserveUnknownFieldsRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set spec.preserveUnknownFields to false (or remove it) on every CRD served by a conversion webhook.",
+		BadExample: `kind: CustomResourceDefinition
+spec:
+  preserveUnknownFields: true`,
+		GoodExample: `kind: CustomResourceDefinition
+spec:
+  preserveUnknownFields: false`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/adding-admission-and-conversion-webhooks/",
+	}
+}
+
+// Fix sets spec.preserveUnknownFields to false on the flagged CRD
+func (r *ConversionPreserveUnknownFieldsRule) Fix(doc *yaml.Node, violation Violation) (bool, error) {
+	if documentKind(doc) != "CustomResourceDefinition" {
+		return false, nil
+	}
+
+	node := mappingPath(documentRoot(doc), "spec", "preserveUnknownFields")
+	if node == nil || node.Kind != yaml.ScalarNode || !isTrueValue(node.Value) {
+		return false, nil
+	}
+	return setBoolValue(node, false), nil
+}
+
+func (r *ConversionPreserveUnknownFieldsRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if !csvHasWebhookType(bundle.CSV, "ConversionWebhook") {
+		return "the CSV defines no conversion webhook"
+	}
+	return ""
+}
+
 func (r *ConversionPreserveUnknownFieldsRule) Validate(bundle *Bundle) []Violation {
 	var violations []Violation
 
@@ -69,6 +109,7 @@ func (r *ConversionPreserveUnknownFieldsRule) Validate(bundle *Bundle) []Violati
 				Message: fmt.Sprintf("CRD '%s' is targeted by conversion webhook but has preserveUnknownFields=true",
 					crdFullName),
 				File: crd.FilePath,
+				Line: lineOf(crd.Node, "spec", "preserveUnknownFields"),
 				Description: "CRDs used with conversion webhooks must have spec.preserveUnknownFields set to false or nil. Set it to false.",
 				Fixable: r.Fixable(),
 			})
@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ODH-OLM-011: Webhook timeoutSeconds Out of Range
+
+const (
+	minWebhookTimeoutSeconds = 1
+	maxWebhookTimeoutSeconds = 30
+	// highWebhookTimeoutSeconds is the threshold above which a Fail-policy
+	// webhook can noticeably stall API requests while the operator is down
+	highWebhookTimeoutSeconds = 10
+)
+
+type WebhookTimeoutRule struct{}
+
+func (r *WebhookTimeoutRule) ID() string {
+	return "ODH-OLM-011"
+}
+
+func (r *WebhookTimeoutRule) Name() string {
+	return "webhook-timeout-range"
+}
+
+func (r *WebhookTimeoutRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *WebhookTimeoutRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *WebhookTimeoutRule) Description() string {
+	return "Admission webhook timeoutSeconds must be between 1 and 30; the API server rejects webhook configurations outside that range. Webhooks with failurePolicy Fail should also keep the timeout short, since every intercepted request waits that long when the operator is unavailable."
+}
+
+func (r *WebhookTimeoutRule) Fixable() bool {
+	return false
+}
+
+func (r *WebhookTimeoutRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set timeoutSeconds between 1 and 30, and keep it at 10 or below for webhooks with failurePolicy Fail.",
+		BadExample: `webhookdefinitions:
+- type: ValidatingAdmissionWebhook
+  failurePolicy: Fail
+  timeoutSeconds: 60`,
+		GoodExample: `webhookdefinitions:
+- type: ValidatingAdmissionWebhook
+  failurePolicy: Fail
+  timeoutSeconds: 5`,
+		DocsURL: "https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#timeouts",
+	}
+}
+
+func (r *WebhookTimeoutRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.WebhookDefinitions) == 0 {
+		return skipNoWebhooks
+	}
+	return ""
+}
+
+func (r *WebhookTimeoutRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for i, webhook := range bundle.CSV.Spec.WebhookDefinitions {
+		if webhook.TimeoutSeconds == nil {
+			continue
+		}
+		timeout := *webhook.TimeoutSeconds
+		line := lineOf(bundle.CSV.Node, "spec", "webhookdefinitions", strconv.Itoa(i), "timeoutSeconds")
+
+		if timeout < minWebhookTimeoutSeconds || timeout > maxWebhookTimeoutSeconds {
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("Webhook '%s' has timeoutSeconds=%d, outside the valid range %d-%d",
+					webhook.GenerateName, timeout, minWebhookTimeoutSeconds, maxWebhookTimeoutSeconds),
+				File:        bundle.CSV.FilePath,
+				Line:        line,
+				Description: "The API server rejects webhook configurations with timeoutSeconds below 1 or above 30. OLM will fail to install the webhook.",
+				Fixable:     r.Fixable(),
+			})
+			continue
+		}
+
+		if webhook.FailurePolicy == "Fail" && timeout > highWebhookTimeoutSeconds {
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: SeverityWarning,
+				Message: fmt.Sprintf("Webhook '%s' has failurePolicy Fail with a high timeoutSeconds=%d",
+					webhook.GenerateName, timeout),
+				File: bundle.CSV.FilePath,
+				Line: line,
+				Description: fmt.Sprintf("When the operator is unavailable, every request intercepted by a Fail-policy webhook blocks for the full timeout. Consider a timeout of %d seconds or less.",
+					highWebhookTimeoutSeconds),
+				Fixable: r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
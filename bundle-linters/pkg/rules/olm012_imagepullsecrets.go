@@ -0,0 +1,100 @@
+package rules
+
+import "fmt"
+
+// ODH-OLM-012: Image Pull Secret Not Shipped in Bundle
+
+type ImagePullSecretsRule struct{}
+
+func (r *ImagePullSecretsRule) ID() string {
+	return "ODH-OLM-012"
+}
+
+func (r *ImagePullSecretsRule) Name() string {
+	return "imagepullsecret-not-bundled"
+}
+
+func (r *ImagePullSecretsRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *ImagePullSecretsRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *ImagePullSecretsRule) Description() string {
+	return "Install deployments that reference imagePullSecrets not shipped as Secret resources in the bundle will fail to pull images unless the secret is created in the install namespace beforehand. Some secrets are legitimately provided at install time, so this is a warning."
+}
+
+func (r *ImagePullSecretsRule) Fixable() bool {
+	return false
+}
+
+func (r *ImagePullSecretsRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Ship the pull secret as a Secret manifest in the bundle, or document that it must exist in the install namespace before the operator is installed.",
+		BadExample: `# CSV deployment references a secret the bundle does not contain
+spec:
+  template:
+    spec:
+      imagePullSecrets:
+      - name: my-registry-creds`,
+		GoodExample: `# manifests/my-registry-creds.secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: my-registry-creds
+type: kubernetes.io/dockerconfigjson`,
+		DocsURL: "https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/",
+	}
+}
+
+func (r *ImagePullSecretsRule) SkipReason(bundle *Bundle) string {
+	if bundle.SingleManifest {
+		return skipSingleManifest
+	}
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *ImagePullSecretsRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	bundledSecrets := make(map[string]bool)
+	for _, resource := range bundle.OtherResources {
+		if resource.Kind == "Secret" {
+			bundledSecrets[resource.Metadata.Name] = true
+		}
+	}
+
+	for _, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		for _, secret := range deployment.Spec.Template.Spec.ImagePullSecrets {
+			if secret == "" || bundledSecrets[secret] {
+				continue
+			}
+
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("Deployment '%s' references imagePullSecret '%s' which is not shipped in the bundle",
+					deployment.Name, secret),
+				File:        bundle.CSV.FilePath,
+				Description: "The secret must exist in the install namespace before the operator starts, otherwise image pulls will fail. Ship it in the bundle or document it as an install prerequisite.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,89 @@
+package rules
+
+import "fmt"
+
+// ODH-OLM-013: Hardcoded caBundle in CRD Conversion Webhook
+
+type ConversionCABundleRule struct{}
+
+func (r *ConversionCABundleRule) ID() string {
+	return "ODH-OLM-013"
+}
+
+func (r *ConversionCABundleRule) Name() string {
+	return "conversion-webhook-hardcoded-cabundle"
+}
+
+func (r *ConversionCABundleRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *ConversionCABundleRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *ConversionCABundleRule) Description() string {
+	return "CRDs should not hardcode spec.conversion.webhook.clientConfig.caBundle. OLM generates the webhook serving certificate and injects its own CA, so a hardcoded bundle goes stale and breaks conversion when certificates rotate."
+}
+
+func (r *ConversionCABundleRule) Fixable() bool {
+	return false
+}
+
+func (r *ConversionCABundleRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Remove caBundle from the CRD's conversion webhook clientConfig and let OLM inject the CA for the webhook declared in the CSV.",
+		BadExample: `spec:
+  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+        caBundle: LS0tLS1CRUdJTi...
+        service:
+          name: my-webhook-service`,
+		GoodExample: `spec:
+  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+        service:
+          name: my-webhook-service`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/adding-admission-and-conversion-webhooks/",
+	}
+}
+
+func (r *ConversionCABundleRule) SkipReason(bundle *Bundle) string {
+	if len(bundle.CRDs) == 0 {
+		return skipNoCRDs
+	}
+	return ""
+}
+
+func (r *ConversionCABundleRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	for _, crd := range bundle.CRDs {
+		conversion := crd.Spec.Conversion
+		if conversion == nil || conversion.Webhook == nil || conversion.Webhook.ClientConfig == nil {
+			continue
+		}
+
+		if conversion.Webhook.ClientConfig.CABundle == "" {
+			continue
+		}
+
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("CRD '%s' hardcodes caBundle in its conversion webhook clientConfig", crd.Metadata.Name),
+			File:        crd.FilePath,
+			Line:        lineOf(crd.Node, "spec", "conversion", "webhook", "clientConfig", "caBundle"),
+			Description: "OLM manages CA injection for conversion webhooks. A hardcoded caBundle will not match the certificate OLM generates and conversion requests will fail TLS verification.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/schema"
+)
+
+// ODH-OLM-014: CSV Does Not Match Custom JSON Schema
+
+// CSVSchemaRule validates the CSV against a user-supplied JSON Schema. It
+// only produces violations when Schema is set (via --csv-schema).
+type CSVSchemaRule struct {
+	Schema *schema.Schema
+}
+
+func (r *CSVSchemaRule) ID() string {
+	return "ODH-OLM-014"
+}
+
+func (r *CSVSchemaRule) Name() string {
+	return "csv-custom-schema"
+}
+
+func (r *CSVSchemaRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *CSVSchemaRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *CSVSchemaRule) Description() string {
+	return "Validates the ClusterServiceVersion against a team-provided JSON Schema passed with --csv-schema. This allows declarative enforcement of internal CSV conventions, such as required annotations or allowed install modes, beyond the built-in rules."
+}
+
+func (r *CSVSchemaRule) Fixable() bool {
+	return false
+}
+
+func (r *CSVSchemaRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Update the CSV so the reported JSON path satisfies the schema, or adjust the schema if the convention changed.",
+		BadExample: `# schema requires metadata.annotations.support
+metadata:
+  annotations:
+    capabilities: Basic Install`,
+		GoodExample: `metadata:
+  annotations:
+    capabilities: Basic Install
+    support: Open Data Hub`,
+		DocsURL: "https://json-schema.org/understanding-json-schema/",
+	}
+}
+
+func (r *CSVSchemaRule) SkipReason(bundle *Bundle) string {
+	if r.Schema == nil {
+		return "no schema was given with --csv-schema"
+	}
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	return ""
+}
+
+func (r *CSVSchemaRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if r.Schema == nil || bundle.CSV == nil || bundle.CSV.Object == nil {
+		return violations
+	}
+
+	for _, schemaErr := range r.Schema.Validate(bundle.CSV.Object) {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("CSV does not match schema at %s: %s", schemaErr.Path, schemaErr.Message),
+			File:        bundle.CSV.FilePath,
+			Description: "The ClusterServiceVersion violates the JSON Schema supplied with --csv-schema.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,140 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ODH-OLM-015: Missing Metrics/Monitoring Wiring
+
+var (
+	defaultMonitoringKinds = []string{"ServiceMonitor", "PodMonitor"}
+	defaultMetricsArgs     = []string{"--metrics-bind-address", "--metrics-addr"}
+)
+
+type MetricsWiringRule struct {
+	// MonitoringKinds are resource kinds that wire up metrics scraping
+	MonitoringKinds []string `yaml:"monitoringKinds"`
+	// MetricsArgs are container argument prefixes that expose a metrics endpoint
+	MetricsArgs []string `yaml:"metricsArgs"`
+}
+
+func (r *MetricsWiringRule) ID() string {
+	return "ODH-OLM-015"
+}
+
+func (r *MetricsWiringRule) Name() string {
+	return "missing-metrics-wiring"
+}
+
+func (r *MetricsWiringRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *MetricsWiringRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *MetricsWiringRule) Description() string {
+	return "Operators should either ship a ServiceMonitor/PodMonitor or expose metrics via a metrics address flag on the manager container. Bundles with neither leave operator metrics silently unavailable in production."
+}
+
+func (r *MetricsWiringRule) Fixable() bool {
+	return false
+}
+
+func (r *MetricsWiringRule) Configure(settings map[string]interface{}) error {
+	return decodeSettings(settings, r)
+}
+
+func (r *MetricsWiringRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Add a ServiceMonitor to the bundle, or pass --metrics-bind-address to the manager container. The accepted indicators can be changed with the monitoringKinds and metricsArgs settings.",
+		BadExample: `containers:
+- name: manager
+  args: ["--leader-elect"]`,
+		GoodExample: `containers:
+- name: manager
+  args: ["--leader-elect", "--metrics-bind-address=:8443"]`,
+		DocsURL: "https://sdk.operatorframework.io/docs/building-operators/golang/advanced-topics/#metrics",
+	}
+}
+
+func (r *MetricsWiringRule) monitoringKinds() []string {
+	if len(r.MonitoringKinds) > 0 {
+		return r.MonitoringKinds
+	}
+	return defaultMonitoringKinds
+}
+
+func (r *MetricsWiringRule) metricsArgs() []string {
+	if len(r.MetricsArgs) > 0 {
+		return r.MetricsArgs
+	}
+	return defaultMetricsArgs
+}
+
+func (r *MetricsWiringRule) SkipReason(bundle *Bundle) string {
+	if bundle.SingleManifest {
+		return skipSingleManifest
+	}
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *MetricsWiringRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil || len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return violations
+	}
+
+	kinds := r.monitoringKinds()
+	for _, resource := range bundle.OtherResources {
+		for _, kind := range kinds {
+			if resource.Kind == kind {
+				return violations
+			}
+		}
+	}
+
+	args := r.metricsArgs()
+	for _, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			if hasArgWithPrefix(container.Command, args) || hasArgWithPrefix(container.Args, args) {
+				return violations
+			}
+		}
+	}
+
+	violations = append(violations, Violation{
+		RuleID:   r.ID(),
+		RuleName: r.Name(),
+		Category: r.Category(),
+		Severity: r.Severity(),
+		Message: fmt.Sprintf("Bundle ships no %s resource and no container sets %s",
+			strings.Join(kinds, "/"), strings.Join(args, " or ")),
+		File:        bundle.CSV.FilePath,
+		Description: "Without a monitoring resource or a metrics endpoint, operator metrics are unavailable. Ship a ServiceMonitor or expose a metrics address on the manager container.",
+		Fixable:     r.Fixable(),
+	})
+
+	return violations
+}
+
+// hasArgWithPrefix reports whether any argument starts with one of the prefixes
+func hasArgWithPrefix(args []string, prefixes []string) bool {
+	for _, arg := range args {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(arg, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
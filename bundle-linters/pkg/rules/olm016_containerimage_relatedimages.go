@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ODH-OLM-016: containerImage Annotation Missing From relatedImages
+
+type ContainerImageRelatedImagesRule struct{}
+
+func (r *ContainerImageRelatedImagesRule) ID() string {
+	return "ODH-OLM-016"
+}
+
+func (r *ContainerImageRelatedImagesRule) Name() string {
+	return "containerimage-not-in-relatedimages"
+}
+
+func (r *ContainerImageRelatedImagesRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *ContainerImageRelatedImagesRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *ContainerImageRelatedImagesRule) Description() string {
+	return "The image in the CSV's containerImage annotation should also be listed in spec.relatedImages. Mirroring tools for disconnected clusters rely on relatedImages and may otherwise skip the operator image."
+}
+
+func (r *ContainerImageRelatedImagesRule) Fixable() bool {
+	return false
+}
+
+func (r *ContainerImageRelatedImagesRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Add the containerImage annotation's image to spec.relatedImages.",
+		BadExample: `metadata:
+  annotations:
+    containerImage: quay.io/org/operator@sha256:abc...
+spec:
+  relatedImages: []`,
+		GoodExample: `metadata:
+  annotations:
+    containerImage: quay.io/org/operator@sha256:abc...
+spec:
+  relatedImages:
+  - name: operator
+    image: quay.io/org/operator@sha256:abc...`,
+		DocsURL: "https://docs.openshift.com/container-platform/latest/operators/operator_sdk/osdk-generating-csvs.html#olm-enabling-operator-for-restricted-network_osdk-generating-csvs",
+	}
+}
+
+func (r *ContainerImageRelatedImagesRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if bundle.CSV.Metadata.Annotations["containerImage"] == "" {
+		return "the CSV has no containerImage annotation"
+	}
+	return ""
+}
+
+func (r *ContainerImageRelatedImagesRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	containerImage := bundle.CSV.Metadata.Annotations["containerImage"]
+	if containerImage == "" {
+		return violations
+	}
+
+	// Entries from the annotation's repository are most likely the one it
+	// should have matched, e.g. the same image under an older tag
+	repository, _, _ := splitImageReference(containerImage)
+	var images, sameRepository []string
+	for _, related := range bundle.CSV.Spec.RelatedImages {
+		if related.Image == containerImage {
+			return violations
+		}
+		images = append(images, related.Image)
+		if relatedRepository, _, _ := splitImageReference(related.Image); relatedRepository == repository {
+			sameRepository = append(sameRepository, related.Image)
+		}
+	}
+
+	var listed string
+	switch {
+	case len(sameRepository) > 0:
+		listed = "it has " + strings.Join(sameRepository, ", ") + " from the same repository"
+	case len(images) > 0:
+		listed = "it lists " + strings.Join(images, ", ")
+	default:
+		listed = "it is empty"
+	}
+
+	violations = append(violations, Violation{
+		RuleID:      r.ID(),
+		RuleName:    r.Name(),
+		Category:    r.Category(),
+		Severity:    r.Severity(),
+		Message:     fmt.Sprintf("containerImage annotation '%s' is not listed in spec.relatedImages; %s", containerImage, listed),
+		File:        bundle.CSV.FilePath,
+		Description: "Disconnected mirroring tools read spec.relatedImages. Add the operator image there so it is mirrored.",
+		Fixable:     r.Fixable(),
+	})
+
+	return violations
+}
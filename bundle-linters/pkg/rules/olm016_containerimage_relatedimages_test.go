@@ -0,0 +1,49 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestContainerImageRelatedImagesRule(t *testing.T) {
+	csv := func(relatedImages string) map[string]string {
+		spec := "version: 1.0.0\nrelatedImages:" + relatedImages
+		return map[string]string{"manifests/csv.yaml": withAnnotations(csvWithSpec(spec), "containerImage: quay.io/example/operator:v1.0.0\n")}
+	}
+
+	runRuleTests(t, &rules.ContainerImageRelatedImagesRule{}, []ruleTest{
+		{
+			name: "listed",
+			files: csv(`
+- name: manager
+  image: quay.io/example/operator:v1.0.0
+`),
+		},
+		{
+			name: "same repository under another tag",
+			files: csv(`
+- name: proxy
+  image: quay.io/example/proxy:v0.14.0
+- name: manager
+  image: quay.io/example/operator:v0.9.0
+`),
+			want: []string{"containerImage annotation 'quay.io/example/operator:v1.0.0' is not listed in spec.relatedImages; it has quay.io/example/operator:v0.9.0 from the same repository"},
+		},
+		{
+			name: "other repositories",
+			files: csv(`
+- name: proxy
+  image: quay.io/example/proxy:v0.14.0
+- name: agent
+  image: quay.io/example/agent:v1.0.0
+`),
+			want: []string{"containerImage annotation 'quay.io/example/operator:v1.0.0' is not listed in spec.relatedImages; it lists quay.io/example/proxy:v0.14.0, quay.io/example/agent:v1.0.0"},
+		},
+		{
+			name:  "no related images",
+			files: csv(" []\n"),
+			want:  []string{"containerImage annotation 'quay.io/example/operator:v1.0.0' is not listed in spec.relatedImages; it is empty"},
+		},
+	})
+}
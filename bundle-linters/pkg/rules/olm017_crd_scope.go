@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ODH-OLM-017: CRD Scope vs Install Mode Mismatch
+
+type CRDScopeInstallModeRule struct{}
+
+func (r *CRDScopeInstallModeRule) ID() string {
+	return "ODH-OLM-017"
+}
+
+func (r *CRDScopeInstallModeRule) Name() string {
+	return "crd-scope-installmode-mismatch"
+}
+
+func (r *CRDScopeInstallModeRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *CRDScopeInstallModeRule) Severity() Severity {
+	return SeverityInfo
+}
+
+func (r *CRDScopeInstallModeRule) Description() string {
+	return "Surfaces CRD scope and install mode combinations that deserve a second look: Namespaced CRDs served by a cluster-wide singleton operator (AllNamespaces with a conversion webhook), and Cluster-scoped CRDs owned by an operator that can only watch its own or selected namespaces."
+}
+
+func (r *CRDScopeInstallModeRule) Fixable() bool {
+	return false
+}
+
+func (r *CRDScopeInstallModeRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Confirm the operator handles the combination correctly, e.g. that a cluster-wide operator reconciles namespaced resources in every namespace, or that a namespace-scoped operator does not assume exclusive ownership of cluster-scoped resources.",
+		BadExample: `# CRD
+spec:
+  scope: Cluster
+# CSV
+installModes:
+- type: OwnNamespace
+  supported: true
+- type: AllNamespaces
+  supported: false`,
+		GoodExample: `# CRD
+spec:
+  scope: Cluster
+# CSV
+installModes:
+- type: AllNamespaces
+  supported: true`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/operator-scoping-with-operatorgroups/",
+	}
+}
+
+func (r *CRDScopeInstallModeRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CRDs) == 0 {
+		return skipNoCRDs
+	}
+	return ""
+}
+
+func (r *CRDScopeInstallModeRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil || len(bundle.CRDs) == 0 {
+		return violations
+	}
+
+	var supportedModes []string
+	allNamespaces := false
+	for _, mode := range bundle.CSV.Spec.InstallModes {
+		if !mode.Supported {
+			continue
+		}
+		supportedModes = append(supportedModes, mode.Type)
+		if mode.Type == "AllNamespaces" {
+			allNamespaces = true
+		}
+	}
+
+	hasConversionWebhook := false
+	for _, webhook := range bundle.CSV.Spec.WebhookDefinitions {
+		if webhook.Type == "ConversionWebhook" {
+			hasConversionWebhook = true
+			break
+		}
+	}
+
+	modes := strings.Join(supportedModes, ", ")
+	if modes == "" {
+		modes = "none"
+	}
+
+	for _, crd := range bundle.CRDs {
+		switch {
+		case crd.Spec.Scope == "Namespaced" && allNamespaces && hasConversionWebhook:
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("CRD '%s' is Namespaced but the operator runs as a cluster-wide singleton (conversion webhook, install modes: %s)",
+					crd.Metadata.Name, modes),
+				File:        crd.FilePath,
+				Line:        lineOf(crd.Node, "spec", "scope"),
+				Description: "A single operator instance will reconcile these resources in every namespace. Make sure it does not assume resources live in its own namespace.",
+				Fixable:     r.Fixable(),
+			})
+
+		case crd.Spec.Scope == "Cluster" && len(supportedModes) > 0 && !allNamespaces:
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("CRD '%s' is Cluster-scoped but the operator does not support AllNamespaces (install modes: %s)",
+					crd.Metadata.Name, modes),
+				File:        crd.FilePath,
+				Line:        lineOf(crd.Node, "spec", "scope"),
+				Description: "Several namespace-scoped installations of the operator may reconcile the same cluster-scoped resources. Make sure they cannot conflict.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
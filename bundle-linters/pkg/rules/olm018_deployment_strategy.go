@@ -0,0 +1,109 @@
+package rules
+
+import "fmt"
+
+// ODH-OLM-018: Deployment Strategy Blocks Upgrades
+
+type DeploymentStrategyRule struct{}
+
+func (r *DeploymentStrategyRule) ID() string {
+	return "ODH-OLM-018"
+}
+
+func (r *DeploymentStrategyRule) Name() string {
+	return "deployment-strategy-upgrade-safety"
+}
+
+func (r *DeploymentStrategyRule) Category() Category {
+	return CategoryUpgrade
+}
+
+func (r *DeploymentStrategyRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *DeploymentStrategyRule) Description() string {
+	return "Operator deployments should use the RollingUpdate strategy with a non-zero maxSurge or maxUnavailable. A RollingUpdate with both set to 0 is rejected by the API server and blocks the install or upgrade; Recreate takes the operator (and its webhooks) down for the duration of every upgrade."
+}
+
+func (r *DeploymentStrategyRule) Fixable() bool {
+	return false
+}
+
+func (r *DeploymentStrategyRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Use the default RollingUpdate strategy, or set maxSurge to at least 1 when maxUnavailable is 0.",
+		BadExample: `deployments:
+- name: my-operator
+  spec:
+    strategy:
+      type: RollingUpdate
+      rollingUpdate:
+        maxUnavailable: 0
+        maxSurge: 0`,
+		GoodExample: `deployments:
+- name: my-operator
+  spec:
+    strategy:
+      type: RollingUpdate
+      rollingUpdate:
+        maxUnavailable: 0
+        maxSurge: 1`,
+		DocsURL: "https://kubernetes.io/docs/concepts/workloads/controllers/deployment/#strategy",
+	}
+}
+
+func (r *DeploymentStrategyRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *DeploymentStrategyRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for _, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		strategy := deployment.Spec.Strategy
+
+		switch strategy.Type {
+		case "Recreate":
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    SeverityWarning,
+				Message:     fmt.Sprintf("Deployment '%s' uses the Recreate strategy", deployment.Name),
+				File:        bundle.CSV.FilePath,
+				Description: "Recreate stops every operator pod before starting the new version, so the operator and any webhooks it serves are unavailable during each upgrade. Fail-policy webhooks will reject requests in that window.",
+				Fixable:     r.Fixable(),
+			})
+
+		case "", "RollingUpdate":
+			// Unset fields default to 25%, so both must be explicitly zero
+			ru := strategy.RollingUpdate
+			if ru == nil || !isZeroValue(ru.MaxUnavailable) || !isZeroValue(ru.MaxSurge) {
+				continue
+			}
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    r.Severity(),
+				Message:     fmt.Sprintf("Deployment '%s' has a RollingUpdate strategy with maxUnavailable=%s and maxSurge=%s", deployment.Name, ru.MaxUnavailable, ru.MaxSurge),
+				File:        bundle.CSV.FilePath,
+				Description: "With both maxUnavailable and maxSurge at 0 no pod can be replaced. The API server rejects the deployment, so OLM cannot install or upgrade the operator.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
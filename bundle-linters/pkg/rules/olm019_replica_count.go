@@ -0,0 +1,106 @@
+package rules
+
+import "fmt"
+
+// ODH-OLM-019: Unreasonable Operator Replica Count
+
+const defaultMaxReplicas = 3
+
+type ReplicaCountRule struct {
+	// MaxReplicas is the highest replica count accepted without a warning
+	MaxReplicas int `yaml:"maxReplicas"`
+}
+
+func (r *ReplicaCountRule) ID() string {
+	return "ODH-OLM-019"
+}
+
+func (r *ReplicaCountRule) Name() string {
+	return "operator-replica-count"
+}
+
+func (r *ReplicaCountRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *ReplicaCountRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *ReplicaCountRule) Description() string {
+	return "Operator deployments rarely need more than a few replicas: with leader election only one replica reconciles, and the rest are standbys. A high replica count is almost always a mistake that wastes cluster resources."
+}
+
+func (r *ReplicaCountRule) Fixable() bool {
+	return false
+}
+
+func (r *ReplicaCountRule) Configure(settings map[string]interface{}) error {
+	if err := decodeSettings(settings, r); err != nil {
+		return err
+	}
+	if r.MaxReplicas < 0 {
+		return fmt.Errorf("maxReplicas must not be negative, got %d", r.MaxReplicas)
+	}
+	return nil
+}
+
+func (r *ReplicaCountRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Run one replica, or two to three with leader election for faster failover. Raise the maxReplicas setting if the operator genuinely scales out.",
+		BadExample: `deployments:
+- name: my-operator
+  spec:
+    replicas: 10`,
+		GoodExample: `deployments:
+- name: my-operator
+  spec:
+    replicas: 2`,
+		DocsURL: "https://sdk.operatorframework.io/docs/building-operators/golang/advanced-topics/#leader-election",
+	}
+}
+
+func (r *ReplicaCountRule) maxReplicas() int {
+	if r.MaxReplicas > 0 {
+		return r.MaxReplicas
+	}
+	return defaultMaxReplicas
+}
+
+func (r *ReplicaCountRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *ReplicaCountRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	max := r.maxReplicas()
+	for _, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas <= max {
+			continue
+		}
+
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("Deployment '%s' has %d replicas, more than the maximum of %d", deployment.Name, *deployment.Spec.Replicas, max),
+			File:        bundle.CSV.FilePath,
+			Description: "Operators typically reconcile from a single leader, so extra replicas only consume resources. Use 1-3 replicas unless the operator is designed to scale out.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
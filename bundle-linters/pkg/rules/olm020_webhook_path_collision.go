@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ODH-OLM-020: Webhook Path Collision Within a Deployment
+
+type WebhookPathCollisionRule struct{}
+
+func (r *WebhookPathCollisionRule) ID() string {
+	return "ODH-OLM-020"
+}
+
+func (r *WebhookPathCollisionRule) Name() string {
+	return "webhook-path-collision"
+}
+
+func (r *WebhookPathCollisionRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *WebhookPathCollisionRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *WebhookPathCollisionRule) Description() string {
+	return "Admission webhooks served by the same deployment must use distinct webhookPath values. OLM creates one Service per deployment, so webhooks sharing a path are all routed to the same handler. Conversion webhooks may share a path, since one conversion handler serves every CRD."
+}
+
+func (r *WebhookPathCollisionRule) Fixable() bool {
+	return false
+}
+
+func (r *WebhookPathCollisionRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Give each admission webhook served by a deployment its own webhookPath, matching the path its handler is registered on.",
+		BadExample: `webhookdefinitions:
+- type: ValidatingAdmissionWebhook
+  generateName: vwidget.example.com
+  deploymentName: my-operator
+  webhookPath: /validate
+- type: MutatingAdmissionWebhook
+  generateName: mwidget.example.com
+  deploymentName: my-operator
+  webhookPath: /validate`,
+		GoodExample: `webhookdefinitions:
+- type: ValidatingAdmissionWebhook
+  generateName: vwidget.example.com
+  deploymentName: my-operator
+  webhookPath: /validate-example-com-v1-widget
+- type: MutatingAdmissionWebhook
+  generateName: mwidget.example.com
+  deploymentName: my-operator
+  webhookPath: /mutate-example-com-v1-widget`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/adding-admission-and-conversion-webhooks/",
+	}
+}
+
+func (r *WebhookPathCollisionRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.WebhookDefinitions) == 0 {
+		return skipNoWebhooks
+	}
+	return ""
+}
+
+func (r *WebhookPathCollisionRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	type pathKey struct {
+		deployment string
+		path       string
+	}
+
+	// Group webhooks by deployment and path, keeping first-seen order
+	var keys []pathKey
+	groups := make(map[pathKey][]WebhookDefinition)
+	for _, webhook := range bundle.CSV.Spec.WebhookDefinitions {
+		if webhook.WebhookPath == "" {
+			continue
+		}
+		key := pathKey{deployment: webhook.DeploymentName, path: webhook.WebhookPath}
+		if _, seen := groups[key]; !seen {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], webhook)
+	}
+
+	for _, key := range keys {
+		webhooks := groups[key]
+		if len(webhooks) < 2 || allConversionWebhooks(webhooks) {
+			continue
+		}
+
+		names := make([]string, 0, len(webhooks))
+		for _, webhook := range webhooks {
+			names = append(names, fmt.Sprintf("'%s' (%s)", webhook.GenerateName, webhook.Type))
+		}
+
+		violations = append(violations, Violation{
+			RuleID:   r.ID(),
+			RuleName: r.Name(),
+			Category: r.Category(),
+			Severity: r.Severity(),
+			Message: fmt.Sprintf("Webhooks %s on deployment '%s' share webhookPath '%s'",
+				strings.Join(names, ", "), key.deployment, key.path),
+			File:        bundle.CSV.FilePath,
+			Description: "All webhooks of a deployment are exposed through one Service, so requests for each of these webhooks reach the same handler. Give each webhook a distinct webhookPath.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
+
+// allConversionWebhooks reports whether every webhook is a conversion webhook
+func allConversionWebhooks(webhooks []WebhookDefinition) bool {
+	for _, webhook := range webhooks {
+		if webhook.Type != "ConversionWebhook" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ODH-OLM-021: Owned CRD Missing displayName/description
+
+type OwnedCRDMetadataRule struct{}
+
+func (r *OwnedCRDMetadataRule) ID() string {
+	return "ODH-OLM-021"
+}
+
+func (r *OwnedCRDMetadataRule) Name() string {
+	return "owned-crd-missing-display-metadata"
+}
+
+func (r *OwnedCRDMetadataRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *OwnedCRDMetadataRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *OwnedCRDMetadataRule) Description() string {
+	return "Owned CRD references in the CSV should set displayName and description. The OpenShift console and OperatorHub show them when users browse the APIs an operator provides, and scorecard's OLM tests expect them."
+}
+
+func (r *OwnedCRDMetadataRule) Fixable() bool {
+	return false
+}
+
+func (r *OwnedCRDMetadataRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Add a human-readable displayName and a one-sentence description to every entry under spec.customresourcedefinitions.owned.",
+		BadExample: `customresourcedefinitions:
+  owned:
+  - name: widgets.example.com
+    version: v1
+    kind: Widget`,
+		GoodExample: `customresourcedefinitions:
+  owned:
+  - name: widgets.example.com
+    version: v1
+    kind: Widget
+    displayName: Widget
+    description: A Widget deploys and manages a widget server.`,
+		DocsURL: "https://olm.operatorframework.io/docs/concepts/crds/clusterserviceversion/#owned-crds",
+	}
+}
+
+func (r *OwnedCRDMetadataRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.CustomResourceDefinitions.Owned) == 0 {
+		return "the CSV owns no CRDs"
+	}
+	return ""
+}
+
+func (r *OwnedCRDMetadataRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for _, owned := range bundle.CSV.Spec.CustomResourceDefinitions.Owned {
+		var missing []string
+		if strings.TrimSpace(owned.DisplayName) == "" {
+			missing = append(missing, "displayName")
+		}
+		if strings.TrimSpace(owned.Description) == "" {
+			missing = append(missing, "description")
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("Owned CRD '%s' (version %s) is missing %s", owned.Name, owned.Version, strings.Join(missing, " and ")),
+			File:        bundle.CSV.FilePath,
+			Description: "Without a displayName and description the console falls back to the raw kind name and shows no explanation of what the resource does.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ODH-OLM-022: Owned CRD Without Categories
+
+type CRDCategoriesRule struct {
+	// RequiredCategory, when set, must appear in every owned CRD's
+	// names.categories (e.g. the product name)
+	RequiredCategory string `yaml:"requiredCategory"`
+}
+
+func (r *CRDCategoriesRule) ID() string {
+	return "ODH-OLM-022"
+}
+
+func (r *CRDCategoriesRule) Name() string {
+	return "crd-missing-categories"
+}
+
+func (r *CRDCategoriesRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *CRDCategoriesRule) Severity() Severity {
+	return SeverityInfo
+}
+
+func (r *CRDCategoriesRule) Description() string {
+	return "Owned CRDs should declare names.categories so users can list all of an operator's resources at once with 'kubectl get <category>'. A shared category can be required with the requiredCategory setting."
+}
+
+func (r *CRDCategoriesRule) Fixable() bool {
+	return false
+}
+
+func (r *CRDCategoriesRule) Configure(settings map[string]interface{}) error {
+	return decodeSettings(settings, r)
+}
+
+func (r *CRDCategoriesRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Add names.categories to each CRD, including a category shared by all of the operator's CRDs (for example the product name).",
+		BadExample: `spec:
+  names:
+    kind: Widget
+    plural: widgets`,
+		GoodExample: `spec:
+  names:
+    kind: Widget
+    plural: widgets
+    categories:
+    - opendatahub`,
+		DocsURL: "https://kubernetes.io/docs/tasks/extend-kubernetes/custom-resources/custom-resource-definitions/#categories",
+	}
+}
+
+func (r *CRDCategoriesRule) SkipReason(bundle *Bundle) string {
+	if len(bundle.CRDs) == 0 {
+		return skipNoCRDs
+	}
+	return ""
+}
+
+func (r *CRDCategoriesRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	// Only CRDs the operator owns are its responsibility. Without a CSV
+	// every bundled CRD is checked.
+	owned := make(map[string]bool)
+	if bundle.CSV != nil {
+		for _, ref := range bundle.CSV.Spec.CustomResourceDefinitions.Owned {
+			owned[ref.Name] = true
+		}
+	}
+
+	for _, crd := range bundle.CRDs {
+		if len(owned) > 0 && !owned[crd.Metadata.Name] {
+			continue
+		}
+
+		categories := crd.Spec.Names.Categories
+		switch {
+		case len(categories) == 0:
+			message := fmt.Sprintf("CRD '%s' declares no names.categories", crd.Metadata.Name)
+			if r.RequiredCategory != "" {
+				message += fmt.Sprintf(" (expected '%s')", r.RequiredCategory)
+			}
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    r.Severity(),
+				Message:     message,
+				File:        crd.FilePath,
+				Description: "Without categories the resource is not included when users run 'kubectl get' for the operator's category.",
+				Fixable:     r.Fixable(),
+			})
+
+		case r.RequiredCategory != "" && !containsString(categories, r.RequiredCategory):
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("CRD '%s' categories [%s] do not include '%s'",
+					crd.Metadata.Name, strings.Join(categories, ", "), r.RequiredCategory),
+				File:        crd.FilePath,
+				Description: fmt.Sprintf("Add '%s' to names.categories so 'kubectl get %s' lists this resource with the operator's other resources.", r.RequiredCategory, r.RequiredCategory),
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
+
+// containsString reports whether list contains value
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// ODH-OLM-023: Missing Required Bundle Annotations
+
+const annotationPrefix = "operators.operatorframework.io.bundle."
+
+type RequiredAnnotationsRule struct{}
+
+func (r *RequiredAnnotationsRule) ID() string {
+	return "ODH-OLM-023"
+}
+
+func (r *RequiredAnnotationsRule) Name() string {
+	return "missing-required-annotations"
+}
+
+func (r *RequiredAnnotationsRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *RequiredAnnotationsRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *RequiredAnnotationsRule) Description() string {
+	return "metadata/annotations.yaml must set the operatorframework bundle annotations mediatype.v1, manifests.v1, metadata.v1, package.v1 and channels.v1. Without them the bundle image is malformed and cannot be added to a catalog."
+}
+
+func (r *RequiredAnnotationsRule) Fixable() bool {
+	return false
+}
+
+func (r *RequiredAnnotationsRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Add every required key to metadata/annotations.yaml, e.g. by regenerating it with 'operator-sdk generate bundle'.",
+		BadExample: `annotations:
+  operators.operatorframework.io.bundle.package.v1: my-operator`,
+		GoodExample: `annotations:
+  operators.operatorframework.io.bundle.mediatype.v1: registry+v1
+  operators.operatorframework.io.bundle.manifests.v1: manifests/
+  operators.operatorframework.io.bundle.metadata.v1: metadata/
+  operators.operatorframework.io.bundle.package.v1: my-operator
+  operators.operatorframework.io.bundle.channels.v1: stable`,
+		DocsURL: "https://olm.operatorframework.io/docs/tasks/creating-operator-bundle/#bundle-annotations",
+	}
+}
+
+func (r *RequiredAnnotationsRule) SkipReason(bundle *Bundle) string {
+	if bundle.SingleManifest {
+		return skipSingleManifest
+	}
+	return ""
+}
+
+func (r *RequiredAnnotationsRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.Annotations == nil {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     "Bundle has no metadata/annotations.yaml",
+			File:        filepath.Join(bundle.MetadataPath, "annotations.yaml"),
+			Description: "The annotations file identifies the bundle's media type, layout, package and channels. OLM and opm cannot use a bundle without it.",
+			Fixable:     r.Fixable(),
+		})
+		return violations
+	}
+
+	annotations := bundle.Annotations
+	required := []struct {
+		key   string
+		value string
+	}{
+		{"mediatype.v1", annotations.MediaType},
+		{"manifests.v1", annotations.Manifests},
+		{"metadata.v1", annotations.Metadata},
+		{"package.v1", annotations.Package},
+		{"channels.v1", strings.Join(annotations.Channels, "")},
+	}
+
+	var missing []string
+	for _, annotation := range required {
+		if strings.TrimSpace(annotation.value) == "" {
+			missing = append(missing, annotationPrefix+annotation.key)
+		}
+	}
+
+	if len(missing) > 0 {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("Bundle annotations are missing or empty: %s", strings.Join(missing, ", ")),
+			File:        annotations.FilePath,
+			Description: "Every required operatorframework bundle annotation must be present and non-empty, otherwise the bundle image is malformed.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
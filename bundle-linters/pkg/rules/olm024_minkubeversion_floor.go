@@ -0,0 +1,152 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-024: minKubeVersion Below Policy Floor
+
+type MinKubeVersionFloorRule struct {
+	// Floor is the lowest Kubernetes version an operator may claim to
+	// support, e.g. "1.23.0". The rule is inactive while it is empty.
+	Floor string `yaml:"floor"`
+}
+
+func (r *MinKubeVersionFloorRule) ID() string {
+	return "ODH-OLM-024"
+}
+
+func (r *MinKubeVersionFloorRule) Name() string {
+	return "minkubeversion-below-floor"
+}
+
+func (r *MinKubeVersionFloorRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *MinKubeVersionFloorRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *MinKubeVersionFloorRule) Description() string {
+	return "Enforces an organization-wide minimum supported Kubernetes version: spec.minKubeVersion must not be lower than the configured floor setting. Unlike ODH-OLM-001, which only checks that the field is present, this rule encodes fleet policy and does nothing until a floor is configured."
+}
+
+func (r *MinKubeVersionFloorRule) Fixable() bool {
+	return false
+}
+
+func (r *MinKubeVersionFloorRule) Configure(settings map[string]interface{}) error {
+	if err := decodeSettings(settings, r); err != nil {
+		return err
+	}
+	if r.Floor != "" {
+		if _, err := parseKubeVersion(r.Floor); err != nil {
+			return fmt.Errorf("invalid floor: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MinKubeVersionFloorRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Raise spec.minKubeVersion to at least the configured floor, after confirming the operator is no longer tested against older releases.",
+		BadExample: `# floor: 1.23.0
+spec:
+  minKubeVersion: 1.19.0`,
+		GoodExample: `# floor: 1.23.0
+spec:
+  minKubeVersion: 1.25.0`,
+		DocsURL: "https://olm.operatorframework.io/docs/concepts/crds/clusterserviceversion/",
+	}
+}
+
+func (r *MinKubeVersionFloorRule) SkipReason(bundle *Bundle) string {
+	if r.Floor == "" {
+		return "no floor is configured in the rule's settings"
+	}
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if bundle.CSV.Spec.MinKubeVersion == "" {
+		return "the CSV does not set spec.minKubeVersion"
+	}
+	return ""
+}
+
+func (r *MinKubeVersionFloorRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if r.Floor == "" || bundle.CSV == nil || bundle.CSV.Spec.MinKubeVersion == "" {
+		return violations
+	}
+
+	floor, err := parseKubeVersion(r.Floor)
+	if err != nil {
+		return violations
+	}
+	declared, err := parseKubeVersion(bundle.CSV.Spec.MinKubeVersion)
+	if err != nil {
+		// Malformed versions are not this rule's concern
+		return violations
+	}
+
+	if compareKubeVersions(declared, floor) < 0 {
+		violations = append(violations, Violation{
+			RuleID:   r.ID(),
+			RuleName: r.Name(),
+			Category: r.Category(),
+			Severity: r.Severity(),
+			Message: fmt.Sprintf("spec.minKubeVersion %s is below the required floor %s",
+				bundle.CSV.Spec.MinKubeVersion, r.Floor),
+			File:        bundle.CSV.FilePath,
+			Line:        lineOf(bundle.CSV.Node, "spec", "minKubeVersion"),
+			Description: "The operator claims support for Kubernetes releases older than the organization supports. Raise minKubeVersion to the floor or above.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
+
+// parseKubeVersion parses versions such as "1.23", "1.23.0" or "v1.23.4"
+// into major, minor and patch numbers. Pre-release and build suffixes are
+// ignored.
+func parseKubeVersion(version string) ([3]int, error) {
+	var parts [3]int
+
+	trimmed := strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(trimmed, "-+"); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+
+	fields := strings.Split(trimmed, ".")
+	if len(fields) < 2 || len(fields) > 3 {
+		return parts, fmt.Errorf("%q is not a major.minor[.patch] version", version)
+	}
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return parts, fmt.Errorf("%q is not a major.minor[.patch] version", version)
+		}
+		parts[i] = n
+	}
+
+	return parts, nil
+}
+
+// compareKubeVersions returns -1, 0 or 1 as a is lower than, equal to or
+// higher than b
+func compareKubeVersions(a, b [3]int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
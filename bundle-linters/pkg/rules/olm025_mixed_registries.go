@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ODH-OLM-025: Operator Images Pulled From Mixed Registries
+
+const defaultMaxRegistries = 1
+
+type MixedRegistriesRule struct {
+	// MaxRegistries is the number of distinct registry hosts accepted
+	// without a warning
+	MaxRegistries int `yaml:"maxRegistries"`
+}
+
+func (r *MixedRegistriesRule) ID() string {
+	return "ODH-OLM-025"
+}
+
+func (r *MixedRegistriesRule) Name() string {
+	return "mixed-image-registries"
+}
+
+func (r *MixedRegistriesRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *MixedRegistriesRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *MixedRegistriesRule) Description() string {
+	return "The operator's container images and spec.relatedImages should come from a single registry. Mixing hosts such as quay.io and registry.redhat.io complicates mirroring for disconnected clusters and usually means a development image slipped into a release."
+}
+
+func (r *MixedRegistriesRule) Fixable() bool {
+	return false
+}
+
+func (r *MixedRegistriesRule) Configure(settings map[string]interface{}) error {
+	if err := decodeSettings(settings, r); err != nil {
+		return err
+	}
+	if r.MaxRegistries < 0 {
+		return fmt.Errorf("maxRegistries must not be negative, got %d", r.MaxRegistries)
+	}
+	return nil
+}
+
+func (r *MixedRegistriesRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Publish every image referenced by the bundle to the same registry, or raise the maxRegistries setting if images are intentionally split.",
+		BadExample: `spec:
+  relatedImages:
+  - name: operator
+    image: registry.redhat.io/org/operator@sha256:abc...
+  - name: proxy
+    image: quay.io/org/proxy@sha256:def...`,
+		GoodExample: `spec:
+  relatedImages:
+  - name: operator
+    image: registry.redhat.io/org/operator@sha256:abc...
+  - name: proxy
+    image: registry.redhat.io/org/proxy@sha256:def...`,
+		DocsURL: "https://docs.openshift.com/container-platform/latest/operators/operator_sdk/osdk-generating-csvs.html#olm-enabling-operator-for-restricted-network_osdk-generating-csvs",
+	}
+}
+
+func (r *MixedRegistriesRule) maxRegistries() int {
+	if r.MaxRegistries > 0 {
+		return r.MaxRegistries
+	}
+	return defaultMaxRegistries
+}
+
+func (r *MixedRegistriesRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	return ""
+}
+
+func (r *MixedRegistriesRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	seen := make(map[string]bool)
+	for _, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			if strings.TrimSpace(container.Image) != "" {
+				seen[imageRegistry(container.Image)] = true
+			}
+		}
+	}
+	for _, related := range bundle.CSV.Spec.RelatedImages {
+		if strings.TrimSpace(related.Image) != "" {
+			seen[imageRegistry(related.Image)] = true
+		}
+	}
+
+	if len(seen) <= r.maxRegistries() {
+		return violations
+	}
+
+	registries := make([]string, 0, len(seen))
+	for registry := range seen {
+		registries = append(registries, registry)
+	}
+	sort.Strings(registries)
+
+	violations = append(violations, Violation{
+		RuleID:   r.ID(),
+		RuleName: r.Name(),
+		Category: r.Category(),
+		Severity: r.Severity(),
+		Message: fmt.Sprintf("Operator images are pulled from %d registries (%s); at most %d expected",
+			len(registries), strings.Join(registries, ", "), r.maxRegistries()),
+		File:        bundle.CSV.FilePath,
+		Description: "Images spread across registries are harder to mirror consistently. Publish all images to one registry.",
+		Fixable:     r.Fixable(),
+	})
+
+	return violations
+}
+
+// imageRegistry returns the registry host of an image reference, following
+// the same convention as docker: the first path component is a host only if
+// it contains a dot or a port, or is localhost. Anything else is on Docker Hub.
+func imageRegistry(image string) string {
+	host, _, found := strings.Cut(image, "/")
+	if !found {
+		return "docker.io"
+	}
+	if strings.ContainsAny(host, ".:") || host == "localhost" {
+		return host
+	}
+	return "docker.io"
+}
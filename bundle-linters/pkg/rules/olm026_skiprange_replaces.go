@@ -0,0 +1,291 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-026: olm.skipRange and spec.replaces Disagree
+
+const skipRangeAnnotation = "olm.skipRange"
+
+type SkipRangeReplacesRule struct{}
+
+func (r *SkipRangeReplacesRule) ID() string {
+	return "ODH-OLM-026"
+}
+
+func (r *SkipRangeReplacesRule) Name() string {
+	return "skiprange-replaces-mismatch"
+}
+
+func (r *SkipRangeReplacesRule) Category() Category {
+	return CategoryUpgrade
+}
+
+func (r *SkipRangeReplacesRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *SkipRangeReplacesRule) Description() string {
+	return "When a CSV sets both the olm.skipRange annotation and spec.replaces, the replaced version should fall inside the skip range, and the range must not include the CSV's own version. Otherwise the upgrade graph OLM builds is ambiguous and clusters on the replaced version may be stranded."
+}
+
+func (r *SkipRangeReplacesRule) Fixable() bool {
+	return false
+}
+
+func (r *SkipRangeReplacesRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Widen olm.skipRange so it covers the version named in spec.replaces, keep its upper bound below spec.version, or drop spec.replaces if the skip range alone describes the upgrade path.",
+		BadExample: `metadata:
+  name: my-operator.v1.3.0
+  annotations:
+    olm.skipRange: ">=1.0.0 <1.2.0"
+spec:
+  version: 1.3.0
+  replaces: my-operator.v1.2.0`,
+		GoodExample: `metadata:
+  name: my-operator.v1.3.0
+  annotations:
+    olm.skipRange: ">=1.0.0 <1.3.0"
+spec:
+  version: 1.3.0
+  replaces: my-operator.v1.2.0`,
+		DocsURL: "https://olm.operatorframework.io/docs/concepts/olm-architecture/operator-catalog/creating-an-update-graph/#skiprange",
+	}
+}
+
+func (r *SkipRangeReplacesRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if bundle.CSV.Metadata.Annotations[skipRangeAnnotation] == "" {
+		return "the CSV has no " + skipRangeAnnotation + " annotation"
+	}
+	if bundle.CSV.Spec.Replaces == "" {
+		return "the CSV does not set spec.replaces"
+	}
+	return ""
+}
+
+func (r *SkipRangeReplacesRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	skipRange := bundle.CSV.Metadata.Annotations[skipRangeAnnotation]
+	replaces := bundle.CSV.Spec.Replaces
+	if skipRange == "" || replaces == "" {
+		return violations
+	}
+
+	report := func(message string) {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     message,
+			File:        bundle.CSV.FilePath,
+			Description: "olm.skipRange and spec.replaces describe conflicting upgrade paths. Make the skip range cover the replaced version and stop below this CSV's version.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	versionRange, err := parseVersionRange(skipRange)
+	if err != nil {
+		report(fmt.Sprintf("%s '%s' cannot be parsed (%v), so its consistency with spec.replaces '%s' cannot be checked",
+			skipRangeAnnotation, skipRange, err, replaces))
+		return violations
+	}
+
+	if replacedVersion, ok := csvNameVersion(replaces); ok && !versionRange.contains(replacedVersion) {
+		report(fmt.Sprintf("spec.replaces '%s' names version %s, which is outside %s '%s'",
+			replaces, replacedVersion, skipRangeAnnotation, skipRange))
+	}
+
+	if ownVersion, err := parseSemver(bundle.CSV.Spec.Version); err == nil && versionRange.contains(ownVersion) {
+		report(fmt.Sprintf("%s '%s' includes the CSV's own version %s (spec.replaces '%s')",
+			skipRangeAnnotation, skipRange, ownVersion, replaces))
+	}
+
+	return violations
+}
+
+// semver is a parsed semantic version. Build metadata is dropped since it
+// does not affect precedence.
+type semver struct {
+	major, minor, patch int
+	prerelease          string
+}
+
+var semverPattern = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+
+// csvNamePattern extracts the version from CSV names such as
+// my-operator.v1.2.3
+var csvNamePattern = regexp.MustCompile(`\.v?(\d+\.\d+\.\d+(?:[-+][0-9A-Za-z.+-]*)?)$`)
+
+func parseSemver(version string) (semver, error) {
+	match := semverPattern.FindStringSubmatch(strings.TrimSpace(version))
+	if match == nil {
+		return semver{}, fmt.Errorf("%q is not a semantic version", version)
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	patch, _ := strconv.Atoi(match[3])
+	return semver{major: major, minor: minor, patch: patch, prerelease: match[4]}, nil
+}
+
+// csvNameVersion returns the version encoded in a CSV name, if any
+func csvNameVersion(name string) (semver, bool) {
+	match := csvNamePattern.FindStringSubmatch(name)
+	if match == nil {
+		return semver{}, false
+	}
+	version, err := parseSemver(match[1])
+	return version, err == nil
+}
+
+func (v semver) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+	if v.prerelease != "" {
+		s += "-" + v.prerelease
+	}
+	return s
+}
+
+// compare returns -1, 0 or 1 following semver precedence rules
+func (v semver) compare(other semver) int {
+	for _, pair := range [][2]int{{v.major, other.major}, {v.minor, other.minor}, {v.patch, other.patch}} {
+		if pair[0] != pair[1] {
+			if pair[0] < pair[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return comparePrerelease(v.prerelease, other.prerelease)
+}
+
+// comparePrerelease compares dot-separated pre-release identifiers. A
+// version without a pre-release has higher precedence than one with.
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	left, right := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(left) && i < len(right); i++ {
+		if left[i] == right[i] {
+			continue
+		}
+		leftNum, leftErr := strconv.Atoi(left[i])
+		rightNum, rightErr := strconv.Atoi(right[i])
+		switch {
+		case leftErr == nil && rightErr == nil:
+			if leftNum < rightNum {
+				return -1
+			}
+			return 1
+		case leftErr == nil:
+			return -1 // Numeric identifiers sort first
+		case rightErr == nil:
+			return 1
+		case left[i] < right[i]:
+			return -1
+		default:
+			return 1
+		}
+	}
+	switch {
+	case len(left) < len(right):
+		return -1
+	case len(left) > len(right):
+		return 1
+	}
+	return 0
+}
+
+// versionRange is a semver range as used by olm.skipRange: comparators
+// separated by spaces must all match, and alternatives are separated by ||
+type versionRange [][]versionComparator
+
+type versionComparator struct {
+	op      string
+	version semver
+}
+
+func parseVersionRange(expression string) (versionRange, error) {
+	var result versionRange
+	for _, alternative := range strings.Split(expression, "||") {
+		fields := strings.Fields(alternative)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("empty range")
+		}
+
+		var comparators []versionComparator
+		for _, field := range fields {
+			op := ""
+			for _, candidate := range []string{">=", "<=", "!=", ">", "<", "="} {
+				if strings.HasPrefix(field, candidate) {
+					op = candidate
+					break
+				}
+			}
+			version, err := parseSemver(strings.TrimPrefix(field, op))
+			if err != nil {
+				return nil, err
+			}
+			if op == "" {
+				op = "="
+			}
+			comparators = append(comparators, versionComparator{op: op, version: version})
+		}
+		result = append(result, comparators)
+	}
+	return result, nil
+}
+
+func (r versionRange) contains(version semver) bool {
+	for _, alternative := range r {
+		matched := true
+		for _, comparator := range alternative {
+			if !comparator.matches(version) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (c versionComparator) matches(version semver) bool {
+	cmp := version.compare(c.version)
+	switch c.op {
+	case ">=":
+		return cmp >= 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case "<":
+		return cmp < 0
+	case "!=":
+		return cmp != 0
+	default:
+		return cmp == 0
+	}
+}
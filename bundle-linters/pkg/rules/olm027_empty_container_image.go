@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ODH-OLM-027: Deployment Container With Empty Image
+
+type EmptyContainerImageRule struct{}
+
+func (r *EmptyContainerImageRule) ID() string {
+	return "ODH-OLM-027"
+}
+
+func (r *EmptyContainerImageRule) Name() string {
+	return "empty-container-image"
+}
+
+func (r *EmptyContainerImageRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *EmptyContainerImageRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *EmptyContainerImageRule) Description() string {
+	return "Every container in the CSV's install deployments must name an image. An empty image, usually left behind by a failed template substitution, makes the deployment invalid and the install fails."
+}
+
+func (r *EmptyContainerImageRule) Fixable() bool {
+	return false
+}
+
+func (r *EmptyContainerImageRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set the container's image, and check the build step that substitutes image references into the CSV.",
+		BadExample: `containers:
+- name: manager
+  image: ""`,
+		GoodExample: `containers:
+- name: manager
+  image: quay.io/org/operator@sha256:abc...`,
+		DocsURL: "https://kubernetes.io/docs/concepts/containers/images/",
+	}
+}
+
+func (r *EmptyContainerImageRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *EmptyContainerImageRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for _, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			if strings.TrimSpace(container.Image) != "" {
+				continue
+			}
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("Container '%s' in deployment '%s' has an empty image",
+					container.Name, deployment.Name),
+				File:        bundle.CSV.FilePath,
+				Description: "A container without an image cannot be scheduled. Set the image, and check for a failed template substitution.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
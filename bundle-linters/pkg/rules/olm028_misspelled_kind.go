@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ODH-OLM-028: Misspelled CustomResourceDefinition Kind
+
+// dispatchedKinds are the kinds the loader parses into dedicated types.
+// Anything else is loaded as a generic resource.
+var dispatchedKinds = []string{"CustomResourceDefinition", "ClusterServiceVersion"}
+
+// maxKindTypoDistance is the largest edit distance, ignoring case, at which
+// a kind is considered a misspelling of a dispatched kind
+const maxKindTypoDistance = 2
+
+type MisspelledKindRule struct{}
+
+func (r *MisspelledKindRule) ID() string {
+	return "ODH-OLM-028"
+}
+
+func (r *MisspelledKindRule) Name() string {
+	return "misspelled-crd-kind"
+}
+
+func (r *MisspelledKindRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *MisspelledKindRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *MisspelledKindRule) Description() string {
+	return "A manifest whose kind is a near miss for CustomResourceDefinition (or ClusterServiceVersion), such as CustomResourceDefinitions or customResourceDefinition, is loaded as a generic resource. The CRD checks then skip it and owned-CRD cross-checks report it as missing, hiding the real problem."
+}
+
+func (r *MisspelledKindRule) Fixable() bool {
+	return false
+}
+
+func (r *MisspelledKindRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Correct the manifest's kind. Kinds are case-sensitive and singular.",
+		BadExample: `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinitions`,
+		GoodExample: `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition`,
+		DocsURL: "https://kubernetes.io/docs/tasks/extend-kubernetes/custom-resources/custom-resource-definitions/",
+	}
+}
+
+func (r *MisspelledKindRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	owned := make(map[string]bool)
+	if bundle.CSV != nil {
+		for _, crd := range bundle.CSV.Spec.CustomResourceDefinitions.Owned {
+			owned[crd.Name] = true
+		}
+	}
+
+	for _, resource := range bundle.OtherResources {
+		intended := intendedKind(resource)
+		if intended == "" {
+			continue
+		}
+
+		message := fmt.Sprintf("Kind '%s' looks like a misspelling of %s; the manifest was not loaded as a %s",
+			resource.Kind, intended, intended)
+		if owned[resource.Metadata.Name] {
+			message += fmt.Sprintf(" (the CSV owns '%s')", resource.Metadata.Name)
+		}
+
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     message,
+			File:        resource.FilePath,
+			Line:        lineOf(resource.Node, "kind"),
+			Description: "Manifests with a misspelled kind are skipped by the checks for that kind. Correct the kind.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
+
+// intendedKind returns the dispatched kind a generic resource was probably
+// meant to be, or "" if its kind is not a near miss. A resource in the
+// apiextensions.k8s.io group can only be a CRD, whatever its kind says.
+func intendedKind(resource *Resource) string {
+	if resource.Kind == "" {
+		return ""
+	}
+	if strings.HasPrefix(resource.APIVersion, "apiextensions.k8s.io/") {
+		return "CustomResourceDefinition"
+	}
+
+	kind := strings.ToLower(resource.Kind)
+	for _, candidate := range dispatchedKinds {
+		if editDistance(kind, strings.ToLower(candidate)) <= maxKindTypoDistance {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// editDistance returns the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ODH-OLM-029: Mis-cased webhookdefinitions Keys
+
+// webhookDefinitionsKey is the only spelling OLM reads
+const webhookDefinitionsKey = "webhookdefinitions"
+
+// webhookDefinitionFields are the keys OLM reads from each entry of
+// spec.webhookdefinitions
+var webhookDefinitionFields = []string{
+	"type", "generateName", "deploymentName", "containerPort", "targetPort",
+	"webhookPath", "admissionReviewVersions", "sideEffects", "failurePolicy",
+	"timeoutSeconds", "rules", "objectSelector", "matchPolicy",
+	"reinvocationPolicy", "conversionCRDs",
+}
+
+type WebhookDefinitionKeysRule struct{}
+
+func (r *WebhookDefinitionKeysRule) ID() string {
+	return "ODH-OLM-029"
+}
+
+func (r *WebhookDefinitionKeysRule) Name() string {
+	return "webhookdefinitions-key-casing"
+}
+
+func (r *WebhookDefinitionKeysRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *WebhookDefinitionKeysRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *WebhookDefinitionKeysRule) Description() string {
+	return "OLM only reads spec.webhookdefinitions, all lowercase, and the exact camelCase field names inside each entry. Variants such as webhookDefinitions or deploymentname are silently ignored, so the webhook, or one of its settings, never reaches the cluster."
+}
+
+func (r *WebhookDefinitionKeysRule) Fixable() bool {
+	return false
+}
+
+func (r *WebhookDefinitionKeysRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Rename the key to its canonical spelling: webhookdefinitions under spec, and the camelCase field names (deploymentName, webhookPath, ...) inside each entry.",
+		BadExample: `spec:
+  webhookDefinitions:
+  - type: ValidatingAdmissionWebhook
+    deploymentname: my-operator`,
+		GoodExample: `spec:
+  webhookdefinitions:
+  - type: ValidatingAdmissionWebhook
+    deploymentName: my-operator`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/adding-admission-and-conversion-webhooks/",
+	}
+}
+
+func (r *WebhookDefinitionKeysRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	return ""
+}
+
+func (r *WebhookDefinitionKeysRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+	spec, ok := bundle.CSV.Object["spec"].(map[string]interface{})
+	if !ok {
+		return violations
+	}
+
+	report := func(message string) {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     message,
+			File:        bundle.CSV.FilePath,
+			Description: "OLM ignores keys that differ from the canonical spelling, so the webhook or setting is silently dropped. Rename the key.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	for _, key := range sortedKeys(spec) {
+		if key != webhookDefinitionsKey && normalizeKey(key) == webhookDefinitionsKey {
+			report(fmt.Sprintf("spec.%s is ignored by OLM; the key must be spec.%s", key, webhookDefinitionsKey))
+		}
+	}
+
+	entries, _ := spec[webhookDefinitionsKey].([]interface{})
+	for i, entry := range entries {
+		fields, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, key := range sortedKeys(fields) {
+			if canonical := canonicalField(key, webhookDefinitionFields); canonical != "" && canonical != key {
+				report(fmt.Sprintf("spec.%s[%d].%s is ignored by OLM; the field must be %s",
+					webhookDefinitionsKey, i, key, canonical))
+			}
+		}
+	}
+
+	return violations
+}
+
+// canonicalField returns the field a key was meant to be, comparing case-
+// and separator-insensitively, or "" if it matches none
+func canonicalField(key string, fields []string) string {
+	normalized := normalizeKey(key)
+	for _, field := range fields {
+		if normalizeKey(field) == normalized {
+			return field
+		}
+	}
+	return ""
+}
+
+// normalizeKey lowercases a key and drops '-' and '_' separators
+func normalizeKey(key string) string {
+	return strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(key))
+}
+
+// sortedKeys returns a map's keys in order, for deterministic reports
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
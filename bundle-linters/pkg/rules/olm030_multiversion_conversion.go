@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ODH-OLM-030: Multi-Version CRD Without Conversion
+
+type MultiVersionConversionRule struct{}
+
+func (r *MultiVersionConversionRule) ID() string {
+	return "ODH-OLM-030"
+}
+
+func (r *MultiVersionConversionRule) Name() string {
+	return "multiversion-crd-without-conversion"
+}
+
+func (r *MultiVersionConversionRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *MultiVersionConversionRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *MultiVersionConversionRule) Description() string {
+	return "A CRD that serves two or more versions without a conversion webhook relies on the None strategy, which only rewrites apiVersion. Unless every served version has an identical schema, clients reading through different versions see inconsistent data."
+}
+
+func (r *MultiVersionConversionRule) Fixable() bool {
+	return false
+}
+
+func (r *MultiVersionConversionRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Configure a conversion webhook for the CRD, either in the CRD itself or via a ConversionWebhook in the CSV's webhookdefinitions, or stop serving the old version.",
+		BadExample: `spec:
+  versions:
+  - name: v1
+    served: true
+    storage: true
+  - name: v1alpha1
+    served: true
+  conversion:
+    strategy: None`,
+		GoodExample: `spec:
+  versions:
+  - name: v1
+    served: true
+    storage: true
+  - name: v1alpha1
+    served: true
+  conversion:
+    strategy: Webhook`,
+		DocsURL: "https://kubernetes.io/docs/tasks/extend-kubernetes/custom-resources/custom-resource-definition-versioning/",
+	}
+}
+
+func (r *MultiVersionConversionRule) SkipReason(bundle *Bundle) string {
+	if len(bundle.CRDs) == 0 {
+		return skipNoCRDs
+	}
+	return ""
+}
+
+func (r *MultiVersionConversionRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	// OLM injects the conversion webhook into CRDs listed in a CSV
+	// ConversionWebhook, whatever the CRD manifest says
+	converted := make(map[string]bool)
+	if bundle.CSV != nil {
+		for _, webhook := range bundle.CSV.Spec.WebhookDefinitions {
+			if webhook.Type != "ConversionWebhook" {
+				continue
+			}
+			for _, crd := range webhook.ConversionCRDs {
+				converted[crd] = true
+			}
+		}
+	}
+
+	for _, crd := range bundle.CRDs {
+		var served []string
+		for _, version := range crd.Spec.Versions {
+			if version.Served {
+				served = append(served, version.Name)
+			}
+		}
+		if len(served) < 2 || converted[crd.Metadata.Name] {
+			continue
+		}
+		if crd.Spec.Conversion != nil && crd.Spec.Conversion.Strategy == "Webhook" {
+			continue
+		}
+
+		strategy := "no conversion strategy"
+		if crd.Spec.Conversion != nil && crd.Spec.Conversion.Strategy != "" {
+			strategy = fmt.Sprintf("conversion strategy '%s'", crd.Spec.Conversion.Strategy)
+		}
+
+		violations = append(violations, Violation{
+			RuleID:   r.ID(),
+			RuleName: r.Name(),
+			Category: r.Category(),
+			Severity: r.Severity(),
+			Message: fmt.Sprintf("CRD '%s' serves %d versions (%s) with %s",
+				crd.Metadata.Name, len(served), strings.Join(served, ", "), strategy),
+			File:        crd.FilePath,
+			Description: "Without a conversion webhook, objects are returned unchanged apart from apiVersion. Add conversion or serve a single version.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
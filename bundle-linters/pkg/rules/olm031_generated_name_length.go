@@ -0,0 +1,161 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// ODH-OLM-031: Generated Name Too Long
+
+const (
+	// maxGeneratedNameLength is the limit for label values and DNS labels,
+	// which is what the names OLM derives from bundle names must fit
+	maxGeneratedNameLength = 63
+	// generatedHashLength is the longest hash suffix OLM appends to the
+	// RBAC names it generates (a 32-bit FNV hash, encoded)
+	generatedHashLength = 10
+)
+
+type GeneratedNameLengthRule struct{}
+
+func (r *GeneratedNameLengthRule) ID() string {
+	return "ODH-OLM-031"
+}
+
+func (r *GeneratedNameLengthRule) Name() string {
+	return "generated-name-length"
+}
+
+func (r *GeneratedNameLengthRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *GeneratedNameLengthRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *GeneratedNameLengthRule) Description() string {
+	return "OLM derives label values, Service names and RBAC object names from the CSV, deployment and service account names. When a derived name exceeds 63 characters the install fails or OLM has to shorten it, and the problem only shows up on-cluster."
+}
+
+func (r *GeneratedNameLengthRule) Fixable() bool {
+	return false
+}
+
+func (r *GeneratedNameLengthRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Shorten the CSV, deployment or service account name so that every name OLM derives from it stays within 63 characters.",
+		BadExample: `metadata:
+  name: open-data-hub-model-serving-platform-operator.v1.2.3
+spec:
+  install:
+    spec:
+      permissions:
+      - serviceAccountName: open-data-hub-model-serving-controller-manager`,
+		GoodExample: `metadata:
+  name: odh-model-serving.v1.2.3
+spec:
+  install:
+    spec:
+      permissions:
+      - serviceAccountName: model-serving-controller`,
+		DocsURL: "https://kubernetes.io/docs/concepts/overview/working-with-objects/names/",
+	}
+}
+
+func (r *GeneratedNameLengthRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	return ""
+}
+
+func (r *GeneratedNameLengthRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+	csv := bundle.CSV
+	csvName := csv.Metadata.Name
+
+	report := func(message string, line int) {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     message,
+			File:        csv.FilePath,
+			Line:        line,
+			Description: "Names derived by OLM must fit in 63 characters. Shorten the CSV, deployment or service account name.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	// OLM labels every object it creates with olm.owner=<csv name>
+	if len(csvName) > maxGeneratedNameLength {
+		report(fmt.Sprintf("CSV name '%s' is %d characters; OLM uses it as the olm.owner label value, which is limited to %d",
+			csvName, len(csvName), maxGeneratedNameLength), lineOf(csv.Node, "metadata", "name"))
+	}
+
+	// Webhooks are served through a Service named <deployment>-service
+	webhookDeployments := make(map[string]bool)
+	for _, webhook := range csv.Spec.WebhookDefinitions {
+		if webhook.DeploymentName != "" {
+			webhookDeployments[webhook.DeploymentName] = true
+		}
+	}
+	for i, dep := range csv.Spec.Install.Spec.Deployments {
+		if !webhookDeployments[dep.Name] {
+			continue
+		}
+		service := dep.Name + "-service"
+		if len(service) > maxGeneratedNameLength {
+			report(fmt.Sprintf("Deployment '%s' backs a webhook; the generated Service name '%s' is %d characters, over the %d-character limit",
+				dep.Name, service, len(service), maxGeneratedNameLength),
+				lineOf(csv.Node, "spec", "install", "spec", "deployments", strconv.Itoa(i), "name"))
+		}
+	}
+
+	// Roles and RoleBindings are named <csv name>-<service account>-<hash>
+	for _, account := range serviceAccountNames(csv) {
+		length := len(csvName) + 1 + len(account) + 1 + generatedHashLength
+		if length > maxGeneratedNameLength {
+			report(fmt.Sprintf("Service account '%s' in CSV '%s' yields generated RBAC names of up to %d characters, over the %d-character limit",
+				account, csvName, length, maxGeneratedNameLength), 0)
+		}
+	}
+
+	return violations
+}
+
+// serviceAccountNames returns the distinct service accounts a CSV grants
+// permissions to or runs deployments as, sorted
+func serviceAccountNames(csv *ClusterServiceVersion) []string {
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if name != "" {
+			seen[name] = true
+		}
+	}
+
+	install := csv.Spec.Install.Spec
+	for _, perm := range install.Permissions {
+		add(perm.ServiceAccountName)
+	}
+	for _, perm := range install.ClusterPermissions {
+		add(perm.ServiceAccountName)
+	}
+	for _, dep := range install.Deployments {
+		add(dep.Spec.Template.Spec.ServiceAccountName)
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+)
+
+// ODH-OLM-032: Install Deployment Mounts a hostPath Volume
+
+type HostPathVolumeRule struct {
+	// AllowedPaths lists host paths that may be mounted, e.g. for a CSI
+	// driver that must reach the kubelet's plugin directory
+	AllowedPaths []string `yaml:"allowedPaths"`
+}
+
+func (r *HostPathVolumeRule) ID() string {
+	return "ODH-OLM-032"
+}
+
+func (r *HostPathVolumeRule) Name() string {
+	return "hostpath-volume"
+}
+
+func (r *HostPathVolumeRule) Category() Category {
+	return CategorySecurity
+}
+
+func (r *HostPathVolumeRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *HostPathVolumeRule) Description() string {
+	return "Install deployments should not mount hostPath volumes. A hostPath volume exposes the node's filesystem to the operator pod, is rejected by restricted pod security admission, and ties the operator to the layout of the nodes it lands on."
+}
+
+func (r *HostPathVolumeRule) Fixable() bool {
+	return false
+}
+
+func (r *HostPathVolumeRule) Configure(settings map[string]interface{}) error {
+	return decodeSettings(settings, r)
+}
+
+func (r *HostPathVolumeRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Replace the hostPath volume with an emptyDir, ConfigMap, Secret or persistent volume. If the operator genuinely needs the host path, list it in the rule's allowedPaths setting.",
+		BadExample: `volumes:
+- name: docker-socket
+  hostPath:
+    path: /var/run/docker.sock`,
+		GoodExample: `volumes:
+- name: cache
+  emptyDir: {}`,
+		DocsURL: "https://kubernetes.io/docs/concepts/storage/volumes/#hostpath",
+	}
+}
+
+func (r *HostPathVolumeRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *HostPathVolumeRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	allowed := make(map[string]bool)
+	for _, p := range r.AllowedPaths {
+		allowed[path.Clean(p)] = true
+	}
+
+	for i, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		for j, volume := range deployment.Spec.Template.Spec.Volumes {
+			if volume.HostPath == nil || allowed[path.Clean(volume.HostPath.Path)] {
+				continue
+			}
+
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("Deployment '%s' mounts host path '%s' through volume '%s'",
+					deployment.Name, volume.HostPath.Path, volume.Name),
+				File: bundle.CSV.FilePath,
+				Line: lineOf(bundle.CSV.Node, "spec", "install", "spec", "deployments", strconv.Itoa(i),
+					"spec", "template", "spec", "volumes", strconv.Itoa(j), "hostPath"),
+				Description: "hostPath volumes expose the node's filesystem to the operator and are rejected by restricted pod security. Use another volume type, or allow the path in the rule's settings.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-033: Operator Container Without Resource Requests or Limits
+
+type ContainerResourcesRule struct{}
+
+func (r *ContainerResourcesRule) ID() string {
+	return "ODH-OLM-033"
+}
+
+func (r *ContainerResourcesRule) Name() string {
+	return "missing-container-resources"
+}
+
+func (r *ContainerResourcesRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *ContainerResourcesRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *ContainerResourcesRule) Description() string {
+	return "Every container in the CSV's install deployments should declare resources.requests and resources.limits. Without requests the scheduler cannot place the operator reliably, and without limits a misbehaving operator can starve other workloads; both make the pod an early eviction candidate on shared clusters."
+}
+
+func (r *ContainerResourcesRule) Fixable() bool {
+	return false
+}
+
+func (r *ContainerResourcesRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Add resources.requests and resources.limits for cpu and memory to every container, sized from the operator's observed usage.",
+		BadExample: `containers:
+- name: manager
+  image: quay.io/org/operator:v1.0.0`,
+		GoodExample: `containers:
+- name: manager
+  image: quay.io/org/operator:v1.0.0
+  resources:
+    requests:
+      cpu: 10m
+      memory: 64Mi
+    limits:
+      cpu: 500m
+      memory: 256Mi`,
+		DocsURL: "https://sdk.operatorframework.io/docs/best-practices/managing-resources/",
+	}
+}
+
+func (r *ContainerResourcesRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *ContainerResourcesRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for i, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		for j, container := range deployment.Spec.Template.Spec.Containers {
+			var missing []string
+			if len(container.Resources.Requests) == 0 {
+				missing = append(missing, "requests")
+			}
+			if len(container.Resources.Limits) == 0 {
+				missing = append(missing, "limits")
+			}
+			if len(missing) == 0 {
+				continue
+			}
+
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("Container '%s' in deployment '%s' has no resource %s",
+					container.Name, deployment.Name, strings.Join(missing, " or ")),
+				File: bundle.CSV.FilePath,
+				Line: lineOf(bundle.CSV.Node, "spec", "install", "spec", "deployments", strconv.Itoa(i),
+					"spec", "template", "spec", "containers", strconv.Itoa(j), "name"),
+				Description: "Containers without requests and limits are hard to schedule and are evicted first under node pressure. Declare cpu and memory requests and limits.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,42 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestContainerResourcesRule(t *testing.T) {
+	pod := func(resources string) map[string]string {
+		return map[string]string{"manifests/csv.yaml": csvWithPod("1", `containers:
+- name: manager
+  image: quay.io/example/operator:v1.0.0
+`+resources)}
+	}
+
+	runRuleTests(t, &rules.ContainerResourcesRule{}, []ruleTest{
+		{
+			name: "requests and limits",
+			files: pod(`  resources:
+    requests:
+      cpu: 10m
+      memory: 64Mi
+    limits:
+      memory: 128Mi
+`),
+		},
+		{
+			name:  "no resources",
+			files: pod(""),
+			want:  []string{"Container 'manager' in deployment 'example-operator' has no resource requests or limits"},
+		},
+		{
+			name: "requests only",
+			files: pod(`  resources:
+    requests:
+      cpu: 10m
+`),
+			want: []string{"Container 'manager' in deployment 'example-operator' has no resource limits"},
+		},
+	})
+}
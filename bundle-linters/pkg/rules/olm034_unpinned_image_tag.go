@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-034: Image Uses the latest Tag or No Tag
+
+type UnpinnedImageTagRule struct{}
+
+func (r *UnpinnedImageTagRule) ID() string {
+	return "ODH-OLM-034"
+}
+
+func (r *UnpinnedImageTagRule) Name() string {
+	return "unpinned-image-tag"
+}
+
+func (r *UnpinnedImageTagRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *UnpinnedImageTagRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *UnpinnedImageTagRule) Description() string {
+	return "Images in the CSV must be pinned to a specific tag or digest. An image with the :latest tag, or no tag at all (which means latest), resolves to whatever was pushed last, so the same bundle installs different code over time and cannot be mirrored reliably. The operator's own image is an error; sidecar images are a warning."
+}
+
+func (r *UnpinnedImageTagRule) Fixable() bool {
+	return false
+}
+
+func (r *UnpinnedImageTagRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Reference every image by digest (image@sha256:...), or at least by a release tag.",
+		BadExample: `containers:
+- name: manager
+  image: quay.io/org/operator:latest
+- name: proxy
+  image: registry.example.com:5000/org/proxy`,
+		GoodExample: `containers:
+- name: manager
+  image: quay.io/org/operator@sha256:4d6c...
+- name: proxy
+  image: registry.example.com:5000/org/proxy:v0.14.0`,
+		DocsURL: "https://sdk.operatorframework.io/docs/olm-integration/generation/#digest-pinning",
+	}
+}
+
+func (r *UnpinnedImageTagRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	return ""
+}
+
+func (r *UnpinnedImageTagRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+	csv := bundle.CSV
+
+	report := func(severity Severity, message string, line int) {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    severity,
+			Message:     message,
+			File:        csv.FilePath,
+			Line:        line,
+			Description: "Unpinned images make installs non-reproducible. Reference the image by digest or by a release tag.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	operatorImage := strings.TrimSpace(csv.Metadata.Annotations["containerImage"])
+	if problem := unpinnedImage(operatorImage); problem != "" {
+		report(r.Severity(), fmt.Sprintf("containerImage annotation '%s' %s", operatorImage, problem),
+			lineOf(csv.Node, "metadata", "annotations", "containerImage"))
+	}
+
+	for i, deployment := range csv.Spec.Install.Spec.Deployments {
+		for j, container := range deployment.Spec.Template.Spec.Containers {
+			image := strings.TrimSpace(container.Image)
+			problem := unpinnedImage(image)
+			if problem == "" {
+				continue
+			}
+
+			// The operator's own container is the one running the
+			// containerImage, or the first one when there is no annotation
+			severity, role := SeverityWarning, "sidecar"
+			if image == operatorImage || (operatorImage == "" && j == 0) {
+				severity, role = r.Severity(), "operator"
+			}
+			report(severity, fmt.Sprintf("Deployment '%s' %s container '%s' image '%s' %s",
+				deployment.Name, role, container.Name, image, problem),
+				lineOf(csv.Node, "spec", "install", "spec", "deployments", strconv.Itoa(i),
+					"spec", "template", "spec", "containers", strconv.Itoa(j), "image"))
+		}
+	}
+
+	return violations
+}
+
+// unpinnedImage describes why an image reference is not pinned, or returns
+// "" if it has a digest or a tag other than latest. Empty references are
+// left to ODH-OLM-027.
+func unpinnedImage(image string) string {
+	if image == "" {
+		return ""
+	}
+	_, tag, digest := splitImageReference(image)
+	switch {
+	case digest != "":
+		return ""
+	case tag == "":
+		return "has no tag or digest (defaults to latest)"
+	case tag == "latest":
+		return "uses the latest tag"
+	}
+	return ""
+}
+
+// splitImageReference splits an image reference into its repository, tag
+// and digest. A colon only starts a tag after the last '/', so registry
+// ports such as registry:5000/org/image are not mistaken for tags.
+func splitImageReference(image string) (repository, tag, digest string) {
+	repository, digest, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, tag = repository[:i], repository[i+1:]
+	}
+	return repository, tag, digest
+}
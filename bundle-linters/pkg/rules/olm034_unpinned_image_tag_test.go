@@ -0,0 +1,48 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestUnpinnedImageTagRule(t *testing.T) {
+	csv := func(containerImage, manager, proxy string) map[string]string {
+		content := csvWithPod("1", `containers:
+- name: manager
+  image: `+manager+`
+- name: proxy
+  image: `+proxy+`
+`)
+		if containerImage != "" {
+			content = withAnnotations(content, "containerImage: "+containerImage+"\n")
+		}
+		return map[string]string{"manifests/csv.yaml": content}
+	}
+
+	runRuleTests(t, &rules.UnpinnedImageTagRule{}, []ruleTest{
+		{
+			name:  "release tags and digests",
+			files: csv("quay.io/example/operator:v1.0.0", "quay.io/example/operator:v1.0.0", "registry.example.com:5000/proxy@sha256:abc"),
+		},
+		{
+			name:  "registry port without a tag",
+			files: csv("", "quay.io/example/operator:v1.0.0", "registry.example.com:5000/proxy"),
+			want:  []string{"Deployment 'example-operator' sidecar container 'proxy' image 'registry.example.com:5000/proxy' has no tag or digest (defaults to latest)"},
+		},
+		{
+			name:  "latest operator image",
+			files: csv("quay.io/example/operator:latest", "quay.io/example/operator:latest", "quay.io/example/proxy:v0.14.0"),
+			want: []string{
+				"containerImage annotation 'quay.io/example/operator:latest' uses the latest tag",
+				"Deployment 'example-operator' operator container 'manager' image 'quay.io/example/operator:latest' uses the latest tag",
+			},
+		},
+		{
+			// Without the annotation the first container is the operator
+			name:  "first container without a tag",
+			files: csv("", "quay.io/example/operator", "quay.io/example/proxy:v0.14.0"),
+			want:  []string{"Deployment 'example-operator' operator container 'manager' image 'quay.io/example/operator' has no tag or digest (defaults to latest)"},
+		},
+	})
+}
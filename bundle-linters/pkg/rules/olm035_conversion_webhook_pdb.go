@@ -0,0 +1,244 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-035: Conversion Webhook Deployment Without a PodDisruptionBudget
+
+type ConversionWebhookPDBRule struct{}
+
+func (r *ConversionWebhookPDBRule) ID() string {
+	return "ODH-OLM-035"
+}
+
+func (r *ConversionWebhookPDBRule) Name() string {
+	return "conversion-webhook-without-pdb"
+}
+
+func (r *ConversionWebhookPDBRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *ConversionWebhookPDBRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *ConversionWebhookPDBRule) Description() string {
+	return "A deployment that serves a conversion webhook with more than one replica should be covered by a PodDisruptionBudget. Every read and write of the converted CRDs goes through the webhook, so losing all replicas at once during a node drain makes those resources unavailable cluster-wide. A PDB that still allows every replica to be evicted is an error."
+}
+
+func (r *ConversionWebhookPDBRule) Fixable() bool {
+	return false
+}
+
+func (r *ConversionWebhookPDBRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Ship a PodDisruptionBudget whose selector matches the webhook deployment's pod labels and that keeps at least one replica available, e.g. maxUnavailable: 1.",
+		BadExample: `# CSV deployment with replicas: 2 serving a ConversionWebhook,
+# and no PodDisruptionBudget in the bundle`,
+		GoodExample: `apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: operator-webhook
+spec:
+  maxUnavailable: 1
+  selector:
+    matchLabels:
+      app: operator`,
+		DocsURL: "https://kubernetes.io/docs/tasks/run-application/configure-pdb/",
+	}
+}
+
+func (r *ConversionWebhookPDBRule) SkipReason(bundle *Bundle) string {
+	if bundle.SingleManifest {
+		return skipSingleManifest
+	}
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if !csvHasWebhookType(bundle.CSV, "ConversionWebhook") {
+		return "the CSV defines no conversion webhook"
+	}
+	return ""
+}
+
+func (r *ConversionWebhookPDBRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	var pdbs []*Resource
+	for _, resource := range bundle.OtherResources {
+		if resource.Kind == "PodDisruptionBudget" {
+			pdbs = append(pdbs, resource)
+		}
+	}
+
+	checked := make(map[string]bool)
+	for _, webhook := range bundle.CSV.Spec.WebhookDefinitions {
+		if webhook.Type != "ConversionWebhook" || checked[webhook.DeploymentName] {
+			continue
+		}
+		checked[webhook.DeploymentName] = true
+
+		index, deployment := findDeployment(bundle.CSV, webhook.DeploymentName)
+		if deployment == nil {
+			continue
+		}
+		replicas := 1
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+		if replicas <= 1 {
+			continue
+		}
+
+		var covering []*Resource
+		for _, pdb := range pdbs {
+			if selectorMatches(pdb.Spec["selector"], deployment.Spec.Template.Labels) {
+				covering = append(covering, pdb)
+			}
+		}
+
+		if len(covering) == 0 {
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("Deployment '%s' serves conversion webhook '%s' with %d replicas but no PodDisruptionBudget covers its pods",
+					deployment.Name, webhook.GenerateName, replicas),
+				File:        bundle.CSV.FilePath,
+				Line:        lineOf(bundle.CSV.Node, "spec", "install", "spec", "deployments", strconv.Itoa(index), "name"),
+				Description: "A node drain may evict every webhook replica at once, making the converted CRDs unavailable. Add a PodDisruptionBudget that selects the deployment's pods.",
+				Fixable:     r.Fixable(),
+			})
+			continue
+		}
+
+		for _, pdb := range covering {
+			field := pdbAllowsAllDown(pdb, replicas)
+			if field == "" {
+				continue
+			}
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: SeverityError,
+				Message: fmt.Sprintf("PodDisruptionBudget '%s' covers conversion webhook '%s' (deployment '%s') but allows all %d replicas to be unavailable",
+					pdb.Metadata.Name, webhook.GenerateName, deployment.Name, replicas),
+				File:        pdb.FilePath,
+				Line:        lineOf(pdb.Node, "spec", field),
+				Description: "This PodDisruptionBudget does not protect webhook availability. Keep at least one replica available, e.g. maxUnavailable: 1.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
+
+// findDeployment returns the CSV install deployment with the given name
+// and its index, or nil
+func findDeployment(csv *ClusterServiceVersion, name string) (int, *Deployment) {
+	for i := range csv.Spec.Install.Spec.Deployments {
+		if csv.Spec.Install.Spec.Deployments[i].Name == name {
+			return i, &csv.Spec.Install.Spec.Deployments[i]
+		}
+	}
+	return -1, nil
+}
+
+// selectorMatches evaluates a decoded label selector against a set of
+// labels. A missing selector matches nothing and an empty one everything,
+// as for policy/v1 PodDisruptionBudgets.
+func selectorMatches(selector interface{}, labels map[string]string) bool {
+	fields, ok := selector.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	matchLabels, _ := fields["matchLabels"].(map[string]interface{})
+	for key, value := range matchLabels {
+		actual, ok := labels[key]
+		if !ok || actual != fmt.Sprint(value) {
+			return false
+		}
+	}
+
+	expressions, _ := fields["matchExpressions"].([]interface{})
+	for _, entry := range expressions {
+		expression, ok := entry.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		key := fmt.Sprint(expression["key"])
+		actual, present := labels[key]
+		values, _ := expression["values"].([]interface{})
+		inValues := false
+		for _, value := range values {
+			if fmt.Sprint(value) == actual {
+				inValues = true
+			}
+		}
+
+		switch expression["operator"] {
+		case "In":
+			if !present || !inValues {
+				return false
+			}
+		case "NotIn":
+			if present && inValues {
+				return false
+			}
+		case "Exists":
+			if !present {
+				return false
+			}
+		case "DoesNotExist":
+			if present {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// pdbAllowsAllDown reports which field of a PodDisruptionBudget permits
+// evicting every one of replicas pods, or "" if it keeps one available
+func pdbAllowsAllDown(pdb *Resource, replicas int) string {
+	if minAvail, ok := pdb.Spec["minAvailable"]; ok {
+		if isZeroValue(minAvail) {
+			return "minAvailable"
+		}
+		return ""
+	}
+
+	allDown := false
+	switch v := pdb.Spec["maxUnavailable"].(type) {
+	case int:
+		allDown = v >= replicas
+	case string:
+		trimmed := strings.TrimSpace(v)
+		if percent, found := strings.CutSuffix(trimmed, "%"); found {
+			n, err := strconv.Atoi(percent)
+			allDown = err == nil && n >= 100
+		} else {
+			n, err := strconv.Atoi(trimmed)
+			allDown = err == nil && n >= replicas
+		}
+	}
+	if allDown {
+		return "maxUnavailable"
+	}
+	return ""
+}
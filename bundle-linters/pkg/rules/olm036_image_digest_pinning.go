@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-036: Image Not Pinned by sha256 Digest
+
+type ImageDigestPinningRule struct {
+	// Ignore lists image repository prefixes that may be referenced by
+	// tag, e.g. internal development registries
+	Ignore []string `yaml:"ignore"`
+}
+
+func (r *ImageDigestPinningRule) ID() string {
+	return "ODH-OLM-036"
+}
+
+func (r *ImageDigestPinningRule) Name() string {
+	return "image-digest-pinning"
+}
+
+func (r *ImageDigestPinningRule) Category() Category {
+	return CategorySecurity
+}
+
+func (r *ImageDigestPinningRule) Severity() Severity {
+	return SeverityInfo
+}
+
+func (r *ImageDigestPinningRule) Description() string {
+	return "Production bundles should reference the operator's images by sha256 digest rather than by tag. A tag is mutable: whoever can push to the repository can change what a released bundle installs, and digest-pinned images are required for disconnected mirroring. The rule is info by default; raise its severity in the config file to enforce it."
+}
+
+func (r *ImageDigestPinningRule) Fixable() bool {
+	return false
+}
+
+func (r *ImageDigestPinningRule) Configure(settings map[string]interface{}) error {
+	return decodeSettings(settings, r)
+}
+
+func (r *ImageDigestPinningRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Resolve each tag to its digest when building the bundle (e.g. operator-sdk generate bundle --use-image-digests) and reference the image as repository@sha256:<digest>.",
+		BadExample: `metadata:
+  annotations:
+    containerImage: quay.io/org/operator:v1.2.3`,
+		GoodExample: `metadata:
+  annotations:
+    containerImage: quay.io/org/operator@sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08`,
+		DocsURL: "https://sdk.operatorframework.io/docs/olm-integration/generation/#digest-pinning",
+	}
+}
+
+func (r *ImageDigestPinningRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	return ""
+}
+
+func (r *ImageDigestPinningRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+	csv := bundle.CSV
+
+	check := func(image, where string, line int) {
+		image = strings.TrimSpace(image)
+		if image == "" || isDigestPinned(image) || r.ignored(image) {
+			return
+		}
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("%s image '%s' is not pinned by sha256 digest", where, image),
+			File:        csv.FilePath,
+			Line:        line,
+			Description: "Tags are mutable, so a released bundle can silently change. Reference the image as repository@sha256:<digest>.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	check(csv.Metadata.Annotations["containerImage"], "containerImage annotation",
+		lineOf(csv.Node, "metadata", "annotations", "containerImage"))
+
+	for i, deployment := range csv.Spec.Install.Spec.Deployments {
+		for j, container := range deployment.Spec.Template.Spec.Containers {
+			check(container.Image, fmt.Sprintf("Deployment '%s' container '%s'", deployment.Name, container.Name),
+				lineOf(csv.Node, "spec", "install", "spec", "deployments", strconv.Itoa(i),
+					"spec", "template", "spec", "containers", strconv.Itoa(j), "image"))
+		}
+	}
+
+	return violations
+}
+
+// ignored reports whether an image's repository starts with one of the
+// configured prefixes
+func (r *ImageDigestPinningRule) ignored(image string) bool {
+	repository, _, _ := splitImageReference(image)
+	for _, prefix := range r.Ignore {
+		if prefix != "" && strings.HasPrefix(repository, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isDigestPinned reports whether an image reference ends in a well-formed
+// sha256 digest. A tag alongside the digest (image:v1@sha256:...) is
+// allowed, since the digest is what gets pulled.
+func isDigestPinned(image string) bool {
+	_, _, digest := splitImageReference(image)
+	hex, found := strings.CutPrefix(digest, "sha256:")
+	if !found || len(hex) != 64 {
+		return false
+	}
+	for _, c := range hex {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return false
+		}
+	}
+	return true
+}
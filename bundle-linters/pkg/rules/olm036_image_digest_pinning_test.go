@@ -0,0 +1,44 @@
+package rules_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestImageDigestPinningRule(t *testing.T) {
+	const (
+		pinned = "quay.io/example/operator@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+		tagged = "quay.io/example/operator:v1.0.0"
+		proxy  = "registry.example.com:5000/proxy:v0.14.0"
+	)
+	csv := func(containerImage string, images ...string) map[string]string {
+		containers := "containers:\n"
+		for i, image := range images {
+			containers += fmt.Sprintf("- name: c%d\n  image: %s\n", i, image)
+		}
+		return map[string]string{"manifests/csv.yaml": withAnnotations(csvWithPod("1", containers), "containerImage: "+containerImage+"\n")}
+	}
+
+	runRuleTests(t, &rules.ImageDigestPinningRule{}, []ruleTest{
+		{name: "digests", files: csv(pinned, pinned, "quay.io/example/proxy:v1@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")},
+		{
+			name:  "tags",
+			files: csv(tagged, pinned, proxy),
+			want: []string{
+				"containerImage annotation image '" + tagged + "' is not pinned by sha256 digest",
+				"Deployment 'example-operator' container 'c1' image '" + proxy + "' is not pinned by sha256 digest",
+			},
+		},
+		{
+			name:  "malformed digest",
+			files: csv(pinned, "quay.io/example/operator@sha256:0123"),
+			want:  []string{"Deployment 'example-operator' container 'c0' image 'quay.io/example/operator@sha256:0123' is not pinned by sha256 digest"},
+		},
+	})
+
+	runRuleTests(t, &rules.ImageDigestPinningRule{Ignore: []string{"registry.example.com:5000/"}}, []ruleTest{
+		{name: "ignored repository", files: csv(pinned, pinned, proxy)},
+	})
+}
@@ -0,0 +1,101 @@
+package rules
+
+import "fmt"
+
+// ODH-OLM-037: Package Annotation Does Not Match the CSV Name
+
+type PackageNameMismatchRule struct{}
+
+func (r *PackageNameMismatchRule) ID() string {
+	return "ODH-OLM-037"
+}
+
+func (r *PackageNameMismatchRule) Name() string {
+	return "package-name-mismatch"
+}
+
+func (r *PackageNameMismatchRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *PackageNameMismatchRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *PackageNameMismatchRule) Description() string {
+	return "The operators.operatorframework.io.bundle.package.v1 annotation must match the package part of the CSV name, i.e. everything before the .vX.Y.Z suffix. When they differ, the bundle is added to one package in the catalog while its CSV name suggests another, which breaks upgrade graphs and confuses catalog tooling."
+}
+
+func (r *PackageNameMismatchRule) Fixable() bool {
+	return false
+}
+
+func (r *PackageNameMismatchRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Rename the CSV to <package>.v<version>, or correct the package annotation in metadata/annotations.yaml so both name the same package.",
+		BadExample: `# manifests/my-operator.clusterserviceversion.yaml
+metadata:
+  name: my-operator.v1.2.3
+# metadata/annotations.yaml
+annotations:
+  operators.operatorframework.io.bundle.package.v1: my-operator-rhods`,
+		GoodExample: `# manifests/my-operator.clusterserviceversion.yaml
+metadata:
+  name: my-operator.v1.2.3
+# metadata/annotations.yaml
+annotations:
+  operators.operatorframework.io.bundle.package.v1: my-operator`,
+		DocsURL: "https://olm.operatorframework.io/docs/tasks/creating-operator-bundle/",
+	}
+}
+
+func (r *PackageNameMismatchRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if bundle.Annotations == nil || bundle.Annotations.Package == "" {
+		return "the bundle has no package annotation"
+	}
+	if _, ok := csvNamePackage(bundle.CSV.Metadata.Name); !ok {
+		return "the CSV name has no .vX.Y.Z version suffix"
+	}
+	return ""
+}
+
+func (r *PackageNameMismatchRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil || bundle.Annotations == nil || bundle.Annotations.Package == "" {
+		return violations
+	}
+
+	csvPackage, ok := csvNamePackage(bundle.CSV.Metadata.Name)
+	if !ok || csvPackage == bundle.Annotations.Package {
+		return violations
+	}
+
+	violations = append(violations, Violation{
+		RuleID:   r.ID(),
+		RuleName: r.Name(),
+		Category: r.Category(),
+		Severity: r.Severity(),
+		Message: fmt.Sprintf("Package annotation '%s' does not match package '%s' from CSV name '%s'",
+			bundle.Annotations.Package, csvPackage, bundle.CSV.Metadata.Name),
+		File:        bundle.Annotations.FilePath,
+		Line:        lineOf(bundle.Annotations.Node, "annotations", annotationPrefix+"package.v1"),
+		Description: "The bundle's package annotation and CSV name must name the same package, otherwise catalogs file the bundle under an unexpected package.",
+		Fixable:     r.Fixable(),
+	})
+
+	return violations
+}
+
+// csvNamePackage returns the package part of a CSV name such as
+// my-operator.v1.2.3, or false if the name has no version suffix
+func csvNamePackage(name string) (string, bool) {
+	loc := csvNamePattern.FindStringIndex(name)
+	if loc == nil || loc[0] == 0 {
+		return "", false
+	}
+	return name[:loc[0]], true
+}
@@ -0,0 +1,67 @@
+package rules
+
+// ODH-OLM-038: Manifest YAML Hygiene
+
+// YAMLLintRule reports the YAML problems the loader found before parsing.
+// It only produces violations for bundles loaded with --yaml-lint.
+type YAMLLintRule struct{}
+
+func (r *YAMLLintRule) ID() string {
+	return "ODH-OLM-038"
+}
+
+func (r *YAMLLintRule) Name() string {
+	return "yaml-lint"
+}
+
+func (r *YAMLLintRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *YAMLLintRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *YAMLLintRule) Description() string {
+	return "With --yaml-lint, manifest files are checked for tab indentation and duplicate mapping keys before they are parsed. Both usually come from hand-editing: YAML forbids tabs in indentation, and a repeated key leaves it unclear which value was meant. Files these problems make unparseable are skipped so the rest of the bundle is still validated."
+}
+
+func (r *YAMLLintRule) Fixable() bool {
+	return false
+}
+
+func (r *YAMLLintRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Indent with spaces only, and remove or merge the repeated key so each key appears once per mapping.",
+		BadExample: `metadata:
+  name: my-operator-webhook
+  labels:
+    app: my-operator
+  name: my-operator`,
+		GoodExample: `metadata:
+  name: my-operator
+  labels:
+    app: my-operator`,
+		DocsURL: "https://yaml.org/spec/1.2.2/#61-indentation-spaces",
+	}
+}
+
+func (r *YAMLLintRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	for _, problem := range bundle.YAMLProblems {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     problem.Message,
+			File:        problem.FilePath,
+			Line:        problem.Line,
+			Description: "Tabs and duplicate keys point at hand-editing mistakes and make manifests ambiguous or unparseable. Indent with spaces and keep each key once per mapping.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ODH-OLM-039: Operator Container Runs Privileged or as Root
+
+type PrivilegedContainerRule struct{}
+
+func (r *PrivilegedContainerRule) ID() string {
+	return "ODH-OLM-039"
+}
+
+func (r *PrivilegedContainerRule) Name() string {
+	return "privileged-container"
+}
+
+func (r *PrivilegedContainerRule) Category() Category {
+	return CategorySecurity
+}
+
+func (r *PrivilegedContainerRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *PrivilegedContainerRule) Description() string {
+	return "Containers in the CSV's install deployments must not run privileged, allow privilege escalation or run as root (runAsUser: 0). Each of these gives a compromised operator control of the node and is rejected by restricted pod security admission. Containers that do not set runAsNonRoot: true, directly or through the pod's securityContext, are a warning unless they run as a non-zero runAsUser."
+}
+
+func (r *PrivilegedContainerRule) Fixable() bool {
+	return false
+}
+
+func (r *PrivilegedContainerRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Remove privileged and allowPrivilegeEscalation: true, run as a non-root user and set runAsNonRoot: true in the container or pod securityContext.",
+		BadExample: `containers:
+- name: manager
+  securityContext:
+    privileged: true
+    runAsUser: 0`,
+		GoodExample: `containers:
+- name: manager
+  securityContext:
+    allowPrivilegeEscalation: false
+    runAsNonRoot: true
+    capabilities:
+      drop: ["ALL"]`,
+		DocsURL: "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+	}
+}
+
+func (r *PrivilegedContainerRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *PrivilegedContainerRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for i, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		pod := deployment.Spec.Template.Spec.SecurityContext
+		if pod == nil {
+			pod = &SecurityContext{}
+		}
+
+		for j, container := range deployment.Spec.Template.Spec.Containers {
+			containerPath := []string{"spec", "install", "spec", "deployments", strconv.Itoa(i),
+				"spec", "template", "spec", "containers", strconv.Itoa(j)}
+			report := func(severity Severity, message, description string, field ...string) {
+				violations = append(violations, Violation{
+					RuleID:      r.ID(),
+					RuleName:    r.Name(),
+					Category:    r.Category(),
+					Severity:    severity,
+					Message:     fmt.Sprintf("Container '%s' in deployment '%s' %s", container.Name, deployment.Name, message),
+					File:        bundle.CSV.FilePath,
+					Line:        lineOf(bundle.CSV.Node, append(containerPath, field...)...),
+					Description: description,
+					Fixable:     r.Fixable(),
+				})
+			}
+
+			sc := container.SecurityContext
+			if sc == nil {
+				sc = &SecurityContext{}
+			}
+
+			if sc.Privileged != nil && *sc.Privileged {
+				report(SeverityError, "runs privileged",
+					"A privileged container has full access to the node. Remove privileged: true.",
+					"securityContext", "privileged")
+			}
+			if sc.AllowPrivilegeEscalation != nil && *sc.AllowPrivilegeEscalation {
+				report(SeverityError, "allows privilege escalation",
+					"Privilege escalation lets processes gain more privileges than the container started with. Set allowPrivilegeEscalation: false.",
+					"securityContext", "allowPrivilegeEscalation")
+			}
+
+			// Container settings override the pod's
+			runAsUser, userField := pod.RunAsUser, []string{"name"}
+			if sc.RunAsUser != nil {
+				runAsUser, userField = sc.RunAsUser, []string{"securityContext", "runAsUser"}
+			}
+			runAsNonRoot := pod.RunAsNonRoot
+			if sc.RunAsNonRoot != nil {
+				runAsNonRoot = sc.RunAsNonRoot
+			}
+
+			switch {
+			case runAsUser != nil && *runAsUser == 0:
+				report(SeverityError, "runs as root (runAsUser: 0)",
+					"Running as root gives a compromised operator root on the node's filesystem and kernel interfaces. Run as a non-root user.",
+					userField...)
+			case runAsUser == nil && (runAsNonRoot == nil || !*runAsNonRoot):
+				report(SeverityWarning, "does not set runAsNonRoot: true",
+					"Without runAsNonRoot: true the container runs as root if its image does. Set runAsNonRoot: true in the container or pod securityContext.",
+					"name")
+			}
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,56 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestPrivilegedContainerRule(t *testing.T) {
+	pod := func(podSecurityContext, securityContext string) map[string]string {
+		return map[string]string{"manifests/csv.yaml": csvWithPod("1", podSecurityContext+`containers:
+- name: manager
+  image: quay.io/example/operator:v1.0.0
+`+securityContext)}
+	}
+
+	runRuleTests(t, &rules.PrivilegedContainerRule{}, []ruleTest{
+		{
+			name:  "restricted container",
+			files: pod("", "  securityContext:\n    runAsNonRoot: true\n    allowPrivilegeEscalation: false\n"),
+		},
+		{
+			name:  "runAsNonRoot from the pod",
+			files: pod("securityContext:\n  runAsNonRoot: true\n", ""),
+		},
+		{
+			name:  "non-root user",
+			files: pod("", "  securityContext:\n    runAsUser: 1000\n"),
+		},
+		{
+			name:  "privileged root container",
+			files: pod("", "  securityContext:\n    privileged: true\n    allowPrivilegeEscalation: true\n    runAsUser: 0\n"),
+			want: []string{
+				"Container 'manager' in deployment 'example-operator' runs privileged",
+				"Container 'manager' in deployment 'example-operator' allows privilege escalation",
+				"Container 'manager' in deployment 'example-operator' runs as root (runAsUser: 0)",
+			},
+		},
+		{
+			name:  "root user from the pod",
+			files: pod("securityContext:\n  runAsUser: 0\n", ""),
+			want:  []string{"Container 'manager' in deployment 'example-operator' runs as root (runAsUser: 0)"},
+		},
+		{
+			name:  "no runAsNonRoot",
+			files: pod("", ""),
+			want:  []string{"Container 'manager' in deployment 'example-operator' does not set runAsNonRoot: true"},
+		},
+		{
+			// The container setting overrides the pod's
+			name:  "runAsNonRoot disabled in the container",
+			files: pod("securityContext:\n  runAsNonRoot: true\n", "  securityContext:\n    runAsNonRoot: false\n"),
+			want:  []string{"Container 'manager' in deployment 'example-operator' does not set runAsNonRoot: true"},
+		},
+	})
+}
@@ -0,0 +1,150 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-040: Owned CRD Without a Matching CRD Manifest
+
+type OwnedCRDManifestRule struct{}
+
+func (r *OwnedCRDManifestRule) ID() string {
+	return "ODH-OLM-040"
+}
+
+func (r *OwnedCRDManifestRule) Name() string {
+	return "owned-crd-manifest"
+}
+
+func (r *OwnedCRDManifestRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *OwnedCRDManifestRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *OwnedCRDManifestRule) Description() string {
+	return "Every CRD listed under spec.customresourcedefinitions.owned must ship as a CustomResourceDefinition manifest in the bundle with the referenced version; otherwise OLM fails the install. CRD manifests in the bundle that the CSV does not declare as owned are a warning, since OLM installs them without the CSV describing them."
+}
+
+func (r *OwnedCRDManifestRule) Fixable() bool {
+	return false
+}
+
+func (r *OwnedCRDManifestRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Add the CRD manifest to manifests/ (e.g. regenerate the bundle with 'make bundle'), fix the owned entry's name (<plural>.<group>) or version, or declare bundled CRDs as owned.",
+		BadExample: `# CSV
+customresourcedefinitions:
+  owned:
+  - name: widgets.example.com
+    version: v1
+    kind: Widget
+# ...but manifests/ contains no widgets.example.com CRD`,
+		GoodExample: `# manifests/example.com_widgets.yaml
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  versions:
+  - name: v1
+    served: true
+    storage: true`,
+		DocsURL: "https://olm.operatorframework.io/docs/concepts/crds/clusterserviceversion/#owned-crds",
+	}
+}
+
+func (r *OwnedCRDManifestRule) SkipReason(bundle *Bundle) string {
+	if bundle.SingleManifest {
+		return skipSingleManifest
+	}
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.CustomResourceDefinitions.Owned) == 0 && len(bundle.CRDs) == 0 {
+		return "the CSV owns no CRDs and the bundle contains none"
+	}
+	return ""
+}
+
+func (r *OwnedCRDManifestRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+	csv := bundle.CSV
+
+	crds := make(map[string]*CustomResourceDefinition)
+	for _, crd := range bundle.CRDs {
+		crds[crd.Metadata.Name] = crd
+	}
+
+	owned := make(map[string]bool)
+	for i, ref := range csv.Spec.CustomResourceDefinitions.Owned {
+		owned[ref.Name] = true
+		line := lineOf(csv.Node, "spec", "customresourcedefinitions", "owned", strconv.Itoa(i), "name")
+
+		crd, ok := crds[ref.Name]
+		if !ok {
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    r.Severity(),
+				Message:     fmt.Sprintf("Owned CRD '%s' has no CustomResourceDefinition manifest in the bundle", ref.Name),
+				File:        csv.FilePath,
+				Line:        line,
+				Description: "OLM cannot install a CRD the bundle does not contain. Add the CRD manifest or fix the owned entry's name.",
+				Fixable:     r.Fixable(),
+			})
+			continue
+		}
+
+		var versions []string
+		found := false
+		for _, version := range crd.Spec.Versions {
+			versions = append(versions, version.Name)
+			if version.Name == ref.Version {
+				found = true
+			}
+		}
+		if ref.Version != "" && !found {
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("Owned CRD '%s' references version '%s', but the CRD manifest defines [%s]",
+					ref.Name, ref.Version, strings.Join(versions, ", ")),
+				File:        csv.FilePath,
+				Line:        lineOf(csv.Node, "spec", "customresourcedefinitions", "owned", strconv.Itoa(i), "version"),
+				Description: "The owned entry must reference a version the CRD serves. Update the entry's version or add the version to the CRD.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	for _, crd := range bundle.CRDs {
+		if owned[crd.Metadata.Name] {
+			continue
+		}
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    SeverityWarning,
+			Message:     fmt.Sprintf("CRD '%s' ships in the bundle but is not declared as owned by the CSV", crd.Metadata.Name),
+			File:        crd.FilePath,
+			Line:        lineOf(crd.Node, "metadata", "name"),
+			Description: "OLM installs every CRD in the bundle, but only owned CRDs get a description in the console and take part in upgrade safety checks. Declare the CRD under spec.customresourcedefinitions.owned or remove it.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
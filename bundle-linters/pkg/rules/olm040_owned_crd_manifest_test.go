@@ -0,0 +1,63 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestOwnedCRDManifestRule(t *testing.T) {
+	crd := func(plural, kind string) string {
+		return `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: ` + plural + `.example.com
+spec:
+  group: example.com
+  names:
+    kind: ` + kind + `
+    plural: ` + plural + `
+  scope: Namespaced
+  versions:
+  - name: v1
+    served: true
+    storage: true
+`
+	}
+	csv := func(version string) string {
+		return csvWithSpec(`version: 1.0.0
+customresourcedefinitions:
+  owned:
+  - name: widgets.example.com
+    version: ` + version + `
+    kind: Widget
+`)
+	}
+
+	runRuleTests(t, &rules.OwnedCRDManifestRule{}, []ruleTest{
+		{
+			name: "owned CRD shipped",
+			files: map[string]string{
+				"manifests/csv.yaml":         csv("v1"),
+				"manifests/widgets.crd.yaml": crd("widgets", "Widget"),
+			},
+		},
+		{
+			name:  "owned CRD missing",
+			files: map[string]string{"manifests/csv.yaml": csv("v1")},
+			want:  []string{"Owned CRD 'widgets.example.com' has no CustomResourceDefinition manifest in the bundle"},
+		},
+		{
+			name: "unknown version and unowned CRD",
+			files: map[string]string{
+				"manifests/csv.yaml":         csv("v2"),
+				"manifests/widgets.crd.yaml": crd("widgets", "Widget"),
+				"manifests/gadgets.crd.yaml": crd("gadgets", "Gadget"),
+			},
+			want: []string{
+				"Owned CRD 'widgets.example.com' references version 'v2', but the CRD manifest defines [v1]",
+				"CRD 'gadgets.example.com' ships in the bundle but is not declared as owned by the CSV",
+			},
+		},
+	})
+}
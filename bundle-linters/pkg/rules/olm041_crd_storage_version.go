@@ -0,0 +1,139 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-041: CRD Without Exactly One Storage Version
+
+type CRDStorageVersionRule struct{}
+
+func (r *CRDStorageVersionRule) ID() string {
+	return "ODH-OLM-041"
+}
+
+func (r *CRDStorageVersionRule) Name() string {
+	return "crd-storage-version"
+}
+
+func (r *CRDStorageVersionRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *CRDStorageVersionRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *CRDStorageVersionRule) Description() string {
+	return "A CRD must mark exactly one of its versions with storage: true. The API server rejects a CRD with no storage version or with several, so the bundle fails to install. A CRD that serves none of its versions is a warning, since its resources cannot be read or written."
+}
+
+func (r *CRDStorageVersionRule) Fixable() bool {
+	return false
+}
+
+func (r *CRDStorageVersionRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set storage: true on exactly one version, normally the newest stable one, and storage: false on the others. Mark at least one version served: true.",
+		BadExample: `versions:
+- name: v1alpha1
+  served: true
+  storage: true
+- name: v1
+  served: true
+  storage: true`,
+		GoodExample: `versions:
+- name: v1alpha1
+  served: true
+  storage: false
+- name: v1
+  served: true
+  storage: true`,
+		DocsURL: "https://kubernetes.io/docs/tasks/extend-kubernetes/custom-resources/custom-resource-definition-versioning/",
+	}
+}
+
+func (r *CRDStorageVersionRule) SkipReason(bundle *Bundle) string {
+	if len(bundle.CRDs) == 0 {
+		return skipNoCRDs
+	}
+	return ""
+}
+
+func (r *CRDStorageVersionRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	for _, crd := range bundle.CRDs {
+		if len(crd.Spec.Versions) == 0 {
+			continue
+		}
+
+		var storage []string
+		second := -1 // Index of the second storage version, reported as the extra one
+		served := false
+		for i, version := range crd.Spec.Versions {
+			if version.Storage {
+				storage = append(storage, version.Name)
+				if len(storage) == 2 {
+					second = i
+				}
+			}
+			served = served || version.Served
+		}
+
+		switch len(storage) {
+		case 1:
+		case 0:
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    r.Severity(),
+				Message:     fmt.Sprintf("CRD '%s' has no storage version (versions: %s)", crd.Metadata.Name, strings.Join(crdVersionNames(crd), ", ")),
+				File:        crd.FilePath,
+				Line:        lineOf(crd.Node, "spec", "versions"),
+				Description: "The API server rejects CRDs without a storage version. Set storage: true on exactly one version.",
+				Fixable:     r.Fixable(),
+			})
+		default:
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    r.Severity(),
+				Message:     fmt.Sprintf("CRD '%s' has %d storage versions: %s", crd.Metadata.Name, len(storage), strings.Join(storage, ", ")),
+				File:        crd.FilePath,
+				Line:        lineOf(crd.Node, "spec", "versions", strconv.Itoa(second), "storage"),
+				Description: "The API server rejects CRDs with more than one storage version. Keep storage: true on exactly one version.",
+				Fixable:     r.Fixable(),
+			})
+		}
+
+		if !served {
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    SeverityWarning,
+				Message:     fmt.Sprintf("CRD '%s' serves none of its versions (%s)", crd.Metadata.Name, strings.Join(crdVersionNames(crd), ", ")),
+				File:        crd.FilePath,
+				Line:        lineOf(crd.Node, "spec", "versions"),
+				Description: "Resources of a CRD with no served version cannot be read or written through the API. Mark at least one version served: true.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
+
+// crdVersionNames returns the names of a CRD's versions in order
+func crdVersionNames(crd *CustomResourceDefinition) []string {
+	var names []string
+	for _, version := range crd.Spec.Versions {
+		names = append(names, version.Name)
+	}
+	return names
+}
@@ -0,0 +1,62 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestCRDStorageVersionRule(t *testing.T) {
+	crd := func(versions string) map[string]string {
+		return map[string]string{"manifests/widgets.crd.yaml": `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  names:
+    kind: Widget
+    plural: widgets
+  scope: Namespaced
+  versions:
+` + indent(versions, 2)}
+	}
+
+	runRuleTests(t, &rules.CRDStorageVersionRule{}, []ruleTest{
+		{
+			name: "one storage version",
+			files: crd(`- name: v1alpha1
+  served: true
+  storage: false
+- name: v1
+  served: true
+  storage: true
+`),
+		},
+		{
+			name: "no storage version",
+			files: crd(`- name: v1alpha1
+  served: true
+  storage: false
+- name: v1
+  served: true
+  storage: false
+`),
+			want: []string{"CRD 'widgets.example.com' has no storage version (versions: v1alpha1, v1)"},
+		},
+		{
+			name: "two storage versions, none served",
+			files: crd(`- name: v1alpha1
+  served: false
+  storage: true
+- name: v1
+  served: false
+  storage: true
+`),
+			want: []string{
+				"CRD 'widgets.example.com' has 2 storage versions: v1alpha1, v1",
+				"CRD 'widgets.example.com' serves none of its versions (v1alpha1, v1)",
+			},
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-042: Admission Webhook Without admissionReviewVersions v1
+
+type AdmissionReviewVersionsRule struct{}
+
+func (r *AdmissionReviewVersionsRule) ID() string {
+	return "ODH-OLM-042"
+}
+
+func (r *AdmissionReviewVersionsRule) Name() string {
+	return "admission-review-versions"
+}
+
+func (r *AdmissionReviewVersionsRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *AdmissionReviewVersionsRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *AdmissionReviewVersionsRule) Description() string {
+	return "Validating and mutating webhooks must list the AdmissionReview versions they understand in admissionReviewVersions. admissionregistration.k8s.io/v1 requires the list, so a webhook without it is rejected when OLM creates the webhook configuration. A list without v1 is a warning, since only v1 is guaranteed to be sent by current API servers."
+}
+
+func (r *AdmissionReviewVersionsRule) Fixable() bool {
+	return false
+}
+
+func (r *AdmissionReviewVersionsRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set admissionReviewVersions on every admission webhook and include v1, making sure the webhook server handles AdmissionReview v1.",
+		BadExample: `webhookdefinitions:
+- type: ValidatingAdmissionWebhook
+  generateName: vwidget.example.com
+  admissionReviewVersions: []`,
+		GoodExample: `webhookdefinitions:
+- type: ValidatingAdmissionWebhook
+  generateName: vwidget.example.com
+  admissionReviewVersions: ["v1"]`,
+		DocsURL: "https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#request",
+	}
+}
+
+func (r *AdmissionReviewVersionsRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if !csvHasWebhookType(bundle.CSV, "ValidatingAdmissionWebhook") && !csvHasWebhookType(bundle.CSV, "MutatingAdmissionWebhook") {
+		return "the CSV defines no admission webhooks"
+	}
+	return ""
+}
+
+func (r *AdmissionReviewVersionsRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for i, webhook := range bundle.CSV.Spec.WebhookDefinitions {
+		if webhook.Type == "ConversionWebhook" {
+			continue
+		}
+		line := lineOf(bundle.CSV.Node, "spec", "webhookdefinitions", strconv.Itoa(i), "admissionReviewVersions")
+
+		if len(webhook.AdmissionReviewVersions) == 0 {
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    r.Severity(),
+				Message:     fmt.Sprintf("Webhook '%s' (%s) has no admissionReviewVersions", webhook.GenerateName, webhook.Type),
+				File:        bundle.CSV.FilePath,
+				Line:        line,
+				Description: "The API server rejects admission webhooks without admissionReviewVersions. Set it to [\"v1\"].",
+				Fixable:     r.Fixable(),
+			})
+			continue
+		}
+
+		if !containsString(webhook.AdmissionReviewVersions, "v1") {
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: SeverityWarning,
+				Message: fmt.Sprintf("Webhook '%s' (%s) admissionReviewVersions [%s] do not include v1",
+					webhook.GenerateName, webhook.Type, strings.Join(webhook.AdmissionReviewVersions, ", ")),
+				File:        bundle.CSV.FilePath,
+				Line:        line,
+				Description: "Older AdmissionReview versions are deprecated. Add v1 to admissionReviewVersions and handle it in the webhook server.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
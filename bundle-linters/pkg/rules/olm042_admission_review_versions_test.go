@@ -0,0 +1,42 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestAdmissionReviewVersionsRule(t *testing.T) {
+	csv := func(versions string) map[string]string {
+		return map[string]string{"manifests/csv.yaml": csvWithSpec(`version: 1.0.0
+webhookdefinitions:
+- type: ValidatingAdmissionWebhook
+  generateName: vwidget.example.com
+  deploymentName: example-operator
+  sideEffects: None
+  failurePolicy: Fail
+  webhookPath: /validate
+` + versions + `- type: ConversionWebhook
+  generateName: cwidget.example.com
+  deploymentName: example-operator
+  sideEffects: None
+  webhookPath: /convert
+  conversionCRDs:
+  - widgets.example.com
+`)}
+	}
+
+	runRuleTests(t, &rules.AdmissionReviewVersionsRule{}, []ruleTest{
+		{name: "v1", files: csv("  admissionReviewVersions: [\"v1\", \"v1beta1\"]\n")},
+		{
+			name:  "no versions",
+			files: csv(""),
+			want:  []string{"Webhook 'vwidget.example.com' (ValidatingAdmissionWebhook) has no admissionReviewVersions"},
+		},
+		{
+			name:  "without v1",
+			files: csv("  admissionReviewVersions: [\"v1beta1\"]\n"),
+			want:  []string{"Webhook 'vwidget.example.com' (ValidatingAdmissionWebhook) admissionReviewVersions [v1beta1] do not include v1"},
+		},
+	})
+}
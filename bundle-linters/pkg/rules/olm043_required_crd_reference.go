@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-043: Malformed Required CRD Reference
+
+type RequiredCRDReferenceRule struct{}
+
+func (r *RequiredCRDReferenceRule) ID() string {
+	return "ODH-OLM-043"
+}
+
+func (r *RequiredCRDReferenceRule) Name() string {
+	return "required-crd-reference"
+}
+
+func (r *RequiredCRDReferenceRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *RequiredCRDReferenceRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *RequiredCRDReferenceRule) Description() string {
+	return "Every CRD listed under spec.customresourcedefinitions.required must have a name, version and kind. OLM resolves the dependency by these fields, so a blank one leaves the install unresolvable or matches the wrong API. A name that is not in <plural>.<group> form is a warning, since it cannot match any CRD."
+}
+
+func (r *RequiredCRDReferenceRule) Fixable() bool {
+	return false
+}
+
+func (r *RequiredCRDReferenceRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Fill in the required entry's name (<plural>.<group>), version and kind to match the CRD provided by the dependency operator.",
+		BadExample: `customresourcedefinitions:
+  required:
+  - name: certificates
+    version: ""
+    kind: Certificate`,
+		GoodExample: `customresourcedefinitions:
+  required:
+  - name: certificates.cert-manager.io
+    version: v1
+    kind: Certificate`,
+		DocsURL: "https://olm.operatorframework.io/docs/concepts/crds/clusterserviceversion/#required-crds",
+	}
+}
+
+func (r *RequiredCRDReferenceRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.CustomResourceDefinitions.Required) == 0 {
+		return "the CSV requires no CRDs"
+	}
+	return ""
+}
+
+func (r *RequiredCRDReferenceRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+	csv := bundle.CSV
+
+	for i, ref := range csv.Spec.CustomResourceDefinitions.Required {
+		path := []string{"spec", "customresourcedefinitions", "required", strconv.Itoa(i)}
+		label := ref.Name
+		if label == "" {
+			label = fmt.Sprintf("#%d", i+1)
+		}
+
+		var missing []string
+		for _, field := range []struct{ name, value string }{
+			{"name", ref.Name},
+			{"version", ref.Version},
+			{"kind", ref.Kind},
+		} {
+			if strings.TrimSpace(field.value) == "" {
+				missing = append(missing, field.name)
+			}
+		}
+		if len(missing) > 0 {
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    r.Severity(),
+				Message:     fmt.Sprintf("Required CRD '%s' has an empty %s", label, strings.Join(missing, ", ")),
+				File:        csv.FilePath,
+				Line:        lineOf(csv.Node, append(path, missing[0])...),
+				Description: "OLM resolves required CRDs by name, version and kind. Set every field to match the CRD the dependency provides.",
+				Fixable:     r.Fixable(),
+			})
+		}
+
+		if ref.Name != "" && !strings.Contains(ref.Name, ".") {
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    SeverityWarning,
+				Message:     fmt.Sprintf("Required CRD name '%s' is not in <plural>.<group> form", ref.Name),
+				File:        csv.FilePath,
+				Line:        lineOf(csv.Node, append(path, "name")...),
+				Description: "CRD names are the resource's plural followed by its API group, e.g. certificates.cert-manager.io. A name without a group cannot match any CRD.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,46 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestRequiredCRDReferenceRule(t *testing.T) {
+	csv := func(required string) map[string]string {
+		return map[string]string{"manifests/csv.yaml": csvWithSpec(`version: 1.0.0
+customresourcedefinitions:
+  required:
+` + indent(required, 2))}
+	}
+
+	runRuleTests(t, &rules.RequiredCRDReferenceRule{}, []ruleTest{
+		{
+			name: "complete reference",
+			files: csv(`- name: certificates.cert-manager.io
+  version: v1
+  kind: Certificate
+`),
+		},
+		{
+			name: "empty fields",
+			files: csv(`- name: certificates.cert-manager.io
+  version: ""
+  kind: Certificate
+- displayName: Issuer
+`),
+			want: []string{
+				"Required CRD 'certificates.cert-manager.io' has an empty version",
+				"Required CRD '#2' has an empty name, version, kind",
+			},
+		},
+		{
+			name: "name without a group",
+			files: csv(`- name: certificates
+  version: v1
+  kind: Certificate
+`),
+			want: []string{"Required CRD name 'certificates' is not in <plural>.<group> form"},
+		},
+	})
+}
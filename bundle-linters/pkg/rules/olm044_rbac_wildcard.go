@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ODH-OLM-044: RBAC Wildcard Grant or cluster-admin Binding
+
+type RBACWildcardRule struct{}
+
+func (r *RBACWildcardRule) ID() string {
+	return "ODH-OLM-044"
+}
+
+func (r *RBACWildcardRule) Name() string {
+	return "rbac-wildcard"
+}
+
+func (r *RBACWildcardRule) Category() Category {
+	return CategorySecurity
+}
+
+func (r *RBACWildcardRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *RBACWildcardRule) Description() string {
+	return "Roles and ClusterRoles shipped in the bundle should not grant every verb on every resource in every API group, and bindings should not reference the cluster-admin ClusterRole. Either one hands the operator's service account full control of the namespace or cluster, so a compromised operator compromises everything it can reach."
+}
+
+func (r *RBACWildcardRule) Fixable() bool {
+	return false
+}
+
+func (r *RBACWildcardRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "List the API groups, resources and verbs the operator actually uses (kubebuilder:rbac markers generate them), and bind the service account to that role instead of cluster-admin.",
+		BadExample: `kind: ClusterRole
+rules:
+- apiGroups: ["*"]
+  resources: ["*"]
+  verbs: ["*"]`,
+		GoodExample: `kind: ClusterRole
+rules:
+- apiGroups: ["apps"]
+  resources: ["deployments"]
+  verbs: ["get", "list", "watch", "create", "update"]`,
+		DocsURL: "https://kubernetes.io/docs/concepts/security/rbac-good-practices/#least-privilege",
+	}
+}
+
+func (r *RBACWildcardRule) SkipReason(bundle *Bundle) string {
+	for _, kind := range []string{"Role", "ClusterRole", "RoleBinding", "ClusterRoleBinding"} {
+		if hasResourceKind(bundle, kind) {
+			return ""
+		}
+	}
+	return "the bundle has no RBAC roles or bindings"
+}
+
+func (r *RBACWildcardRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	for _, resource := range bundle.OtherResources {
+		switch resource.Kind {
+		case "Role", "ClusterRole":
+			policyRules, _ := resource.Fields["rules"].([]interface{})
+			for i, entry := range policyRules {
+				rule, ok := entry.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if !containsString(stringList(rule["apiGroups"]), "*") ||
+					!containsString(stringList(rule["resources"]), "*") ||
+					!containsString(stringList(rule["verbs"]), "*") {
+					continue
+				}
+				violations = append(violations, Violation{
+					RuleID:      r.ID(),
+					RuleName:    r.Name(),
+					Category:    r.Category(),
+					Severity:    r.Severity(),
+					Message:     fmt.Sprintf("%s '%s' grants all verbs on all resources in all API groups", resource.Kind, resource.Metadata.Name),
+					File:        resource.FilePath,
+					Line:        lineOf(resource.Node, "rules", strconv.Itoa(i)),
+					Description: "A full wildcard rule is equivalent to cluster-admin within its scope. Grant only the API groups, resources and verbs the operator uses.",
+					Fixable:     r.Fixable(),
+				})
+			}
+
+		case "RoleBinding", "ClusterRoleBinding":
+			roleRef, _ := resource.Fields["roleRef"].(map[string]interface{})
+			if fmt.Sprint(roleRef["kind"]) != "ClusterRole" || fmt.Sprint(roleRef["name"]) != "cluster-admin" {
+				continue
+			}
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    r.Severity(),
+				Message:     fmt.Sprintf("%s '%s' binds the cluster-admin ClusterRole", resource.Kind, resource.Metadata.Name),
+				File:        resource.FilePath,
+				Line:        lineOf(resource.Node, "roleRef", "name"),
+				Description: "Binding cluster-admin gives the subjects unrestricted access. Bind a role that grants only the permissions the operator needs.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
+
+// stringList converts a decoded YAML sequence of scalars to strings,
+// ignoring anything else
+func stringList(value interface{}) []string {
+	items, _ := value.([]interface{})
+	var list []string
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			list = append(list, s)
+		}
+	}
+	return list
+}
@@ -0,0 +1,66 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestRBACWildcardRule(t *testing.T) {
+	role := func(kind, rules string) string {
+		return `apiVersion: rbac.authorization.k8s.io/v1
+kind: ` + kind + `
+metadata:
+  name: example-role
+rules:
+` + rules
+	}
+	binding := func(kind, roleName string) string {
+		return `apiVersion: rbac.authorization.k8s.io/v1
+kind: ` + kind + `
+metadata:
+  name: example-binding
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: ` + roleName + `
+subjects:
+- kind: ServiceAccount
+  name: example-operator
+  namespace: example
+`
+	}
+
+	runRuleTests(t, &rules.RBACWildcardRule{}, []ruleTest{
+		{
+			name: "scoped rules and bindings",
+			files: map[string]string{
+				"manifests/role.yaml": role("ClusterRole", `- apiGroups: ["*"]
+  resources: ["*"]
+  verbs: ["get", "list", "watch"]
+- apiGroups: ["example.com"]
+  resources: ["*"]
+  verbs: ["*"]
+`),
+				"manifests/binding.yaml": binding("ClusterRoleBinding", "example-role"),
+			},
+		},
+		{
+			name: "full wildcard and cluster-admin",
+			files: map[string]string{
+				"manifests/role.yaml": role("Role", `- apiGroups: [""]
+  resources: ["configmaps"]
+  verbs: ["get"]
+- apiGroups: ["*"]
+  resources: ["*"]
+  verbs: ["*"]
+`),
+				"manifests/binding.yaml": binding("RoleBinding", "cluster-admin"),
+			},
+			want: []string{
+				"RoleBinding 'example-binding' binds the cluster-admin ClusterRole",
+				"Role 'example-role' grants all verbs on all resources in all API groups",
+			},
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ODH-OLM-045: CSV Name Is Not <package>.v<semver>
+
+type CSVNameSemverRule struct{}
+
+func (r *CSVNameSemverRule) ID() string {
+	return "ODH-OLM-045"
+}
+
+func (r *CSVNameSemverRule) Name() string {
+	return "csv-name-semver"
+}
+
+func (r *CSVNameSemverRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *CSVNameSemverRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *CSVNameSemverRule) Description() string {
+	return "The CSV's metadata.name must be <package>.v<version>, where the version is valid semantic versioning (MAJOR.MINOR.PATCH, optionally with a pre-release and build metadata). OLM and catalog tooling parse the name to order bundles, so a name like my-operator.v1.2 breaks upgrade graph resolution. A package part that does not match the package annotation is reported by ODH-OLM-037."
+}
+
+func (r *CSVNameSemverRule) Fixable() bool {
+	return false
+}
+
+func (r *CSVNameSemverRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Rename the CSV to <package>.v<MAJOR>.<MINOR>.<PATCH>, matching spec.version, and update any replaces entries that point at it.",
+		BadExample: `metadata:
+  name: my-operator.v1.2`,
+		GoodExample: `metadata:
+  name: my-operator.v1.2.0
+spec:
+  version: 1.2.0`,
+		DocsURL: "https://olm.operatorframework.io/docs/concepts/crds/clusterserviceversion/",
+	}
+}
+
+func (r *CSVNameSemverRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	return ""
+}
+
+// csvNameVersionPattern splits a CSV name into its package and the version
+// after the last ".v" that is followed by a digit
+var csvNameVersionPattern = regexp.MustCompile(`^(.+)\.v(\d[0-9A-Za-z.+-]*)$`)
+
+func (r *CSVNameSemverRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+	csv := bundle.CSV
+	name := csv.Metadata.Name
+
+	var message string
+	if match := csvNameVersionPattern.FindStringSubmatch(name); match == nil {
+		message = fmt.Sprintf("CSV name '%s' does not end in .v<version>", name)
+	} else if _, err := parseSemver(match[2]); err != nil {
+		message = fmt.Sprintf("CSV name '%s' has version '%s', which is not valid semver (MAJOR.MINOR.PATCH)", name, match[2])
+	} else {
+		return violations
+	}
+
+	violations = append(violations, Violation{
+		RuleID:      r.ID(),
+		RuleName:    r.Name(),
+		Category:    r.Category(),
+		Severity:    r.Severity(),
+		Message:     message,
+		File:        csv.FilePath,
+		Line:        lineOf(csv.Node, "metadata", "name"),
+		Description: "OLM orders bundles by the version in the CSV name. Name the CSV <package>.v<MAJOR>.<MINOR>.<PATCH>, e.g. my-operator.v1.2.0.",
+		Fixable:     r.Fixable(),
+	})
+
+	return violations
+}
@@ -0,0 +1,34 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestCSVNameSemverRule(t *testing.T) {
+	csv := func(name string) map[string]string {
+		return map[string]string{"manifests/csv.yaml": `apiVersion: operators.coreos.com/v1alpha1
+kind: ClusterServiceVersion
+metadata:
+  name: ` + name + `
+spec:
+  version: 1.0.0
+`}
+	}
+
+	runRuleTests(t, &rules.CSVNameSemverRule{}, []ruleTest{
+		{name: "release", files: csv("example-operator.v1.0.0")},
+		{name: "pre-release and build metadata", files: csv("example-operator.v1.0.0-rc.1+build.5")},
+		{
+			name:  "no version",
+			files: csv("example-operator"),
+			want:  []string{"CSV name 'example-operator' does not end in .v<version>"},
+		},
+		{
+			name:  "incomplete version",
+			files: csv("example-operator.v1.2"),
+			want:  []string{"CSV name 'example-operator.v1.2' has version '1.2', which is not valid semver (MAJOR.MINOR.PATCH)"},
+		},
+	})
+}
@@ -0,0 +1,111 @@
+package rules
+
+import "fmt"
+
+// ODH-OLM-046: Hardcoded Namespace in a Bundle Manifest
+
+type HardcodedNamespaceRule struct{}
+
+func (r *HardcodedNamespaceRule) ID() string {
+	return "ODH-OLM-046"
+}
+
+func (r *HardcodedNamespaceRule) Name() string {
+	return "hardcoded-namespace"
+}
+
+func (r *HardcodedNamespaceRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *HardcodedNamespaceRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *HardcodedNamespaceRule) Description() string {
+	return "Bundle manifests should not set metadata.namespace. OLM installs the bundle into the namespace chosen by the OperatorGroup, so a hardcoded namespace either is ignored or puts the resource somewhere the operator does not expect. Cluster-scoped kinds, and the placeholder namespace operator-sdk writes into the CSV, are not reported."
+}
+
+func (r *HardcodedNamespaceRule) Fixable() bool {
+	return false
+}
+
+func (r *HardcodedNamespaceRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Remove metadata.namespace from the manifest (e.g. drop the namespace from the kustomize output used to generate the bundle) and let OLM place the resource in the install namespace.",
+		BadExample: `apiVersion: v1
+kind: Service
+metadata:
+  name: operator-metrics
+  namespace: opendatahub`,
+		GoodExample: `apiVersion: v1
+kind: Service
+metadata:
+  name: operator-metrics`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/ship-operator-supporting-resources/",
+	}
+}
+
+func (r *HardcodedNamespaceRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil && len(bundle.OtherResources) == 0 {
+		return "the bundle has no CSV or other resources"
+	}
+	return ""
+}
+
+// clusterScopedKinds are kinds whose metadata.namespace is meaningless, so
+// a namespace on them is not reported
+var clusterScopedKinds = map[string]bool{
+	"APIService":                     true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"ConsoleCLIDownload":             true,
+	"ConsoleLink":                    true,
+	"ConsoleQuickStart":              true,
+	"ConsoleYAMLSample":              true,
+	"CustomResourceDefinition":       true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"PriorityClass":                  true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+}
+
+func (r *HardcodedNamespaceRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	// operator-sdk writes "namespace: placeholder" into generated CSVs;
+	// OLM replaces it, so only other values are reported
+	if csv := bundle.CSV; csv != nil && csv.Metadata.Namespace != "" && csv.Metadata.Namespace != "placeholder" {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("ClusterServiceVersion '%s' sets namespace '%s'", csv.Metadata.Name, csv.Metadata.Namespace),
+			File:        csv.FilePath,
+			Line:        lineOf(csv.Node, "metadata", "namespace"),
+			Description: "OLM creates the CSV in the install namespace. Remove metadata.namespace from the CSV.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	for _, resource := range bundle.OtherResources {
+		if resource.Metadata.Namespace == "" || clusterScopedKinds[resource.Kind] {
+			continue
+		}
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("%s '%s' hardcodes namespace '%s'", resource.Kind, resource.Metadata.Name, resource.Metadata.Namespace),
+			File:        resource.FilePath,
+			Line:        lineOf(resource.Node, "metadata", "namespace"),
+			Description: "OLM installs bundle resources into the operator's install namespace. Remove metadata.namespace so the resource follows the install.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,49 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestHardcodedNamespaceRule(t *testing.T) {
+	csv := func(namespace string) string {
+		return `apiVersion: operators.coreos.com/v1alpha1
+kind: ClusterServiceVersion
+metadata:
+  name: example-operator.v1.0.0
+  namespace: ` + namespace + `
+spec:
+  version: 1.0.0
+`
+	}
+	resource := func(kind, namespace string) string {
+		apiVersion := "v1"
+		if kind == "ClusterRole" {
+			apiVersion = "rbac.authorization.k8s.io/v1"
+		}
+		return "apiVersion: " + apiVersion + "\nkind: " + kind + "\nmetadata:\n  name: example\n  namespace: " + namespace + "\n"
+	}
+
+	runRuleTests(t, &rules.HardcodedNamespaceRule{}, []ruleTest{
+		{
+			name: "placeholder and cluster-scoped resources",
+			files: map[string]string{
+				"manifests/csv.yaml":         csv("placeholder"),
+				"manifests/clusterrole.yaml": resource("ClusterRole", "example"),
+				"manifests/service.yaml":     "apiVersion: v1\nkind: Service\nmetadata:\n  name: example\n",
+			},
+		},
+		{
+			name: "hardcoded namespaces",
+			files: map[string]string{
+				"manifests/csv.yaml":       csv("example"),
+				"manifests/configmap.yaml": resource("ConfigMap", "example"),
+			},
+			want: []string{
+				"ClusterServiceVersion 'example-operator.v1.0.0' sets namespace 'example'",
+				"ConfigMap 'example' hardcodes namespace 'example'",
+			},
+		},
+	})
+}
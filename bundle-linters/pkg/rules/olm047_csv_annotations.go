@@ -0,0 +1,149 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ODH-OLM-047: CSV Missing Recommended Annotations
+
+var (
+	defaultRequiredCSVAnnotations = []string{"capabilities", "categories", "containerImage", "createdAt", "support", "description"}
+
+	// capabilityLevels are the operator capability levels OperatorHub
+	// recognizes in the capabilities annotation
+	capabilityLevels = []string{"Basic Install", "Seamless Upgrades", "Full Lifecycle", "Deep Insights", "Auto Pilot"}
+)
+
+type CSVAnnotationsRule struct {
+	// RequiredAnnotations are the metadata.annotations keys every CSV must
+	// set, replacing the default list
+	RequiredAnnotations []string `yaml:"requiredAnnotations"`
+}
+
+func (r *CSVAnnotationsRule) ID() string {
+	return "ODH-OLM-047"
+}
+
+func (r *CSVAnnotationsRule) Name() string {
+	return "csv-missing-annotations"
+}
+
+func (r *CSVAnnotationsRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *CSVAnnotationsRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *CSVAnnotationsRule) Description() string {
+	return "The CSV should set the annotations OLM and OperatorHub use to list the operator: capabilities, categories, containerImage, createdAt, support and description. When present, createdAt must be an RFC 3339 timestamp and capabilities one of the known capability levels. The list of required annotations can be changed with the requiredAnnotations setting."
+}
+
+func (r *CSVAnnotationsRule) Fixable() bool {
+	return false
+}
+
+func (r *CSVAnnotationsRule) Configure(settings map[string]interface{}) error {
+	return decodeSettings(settings, r)
+}
+
+func (r *CSVAnnotationsRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Add the missing annotations to the CSV's metadata.annotations (operator-sdk copies them from the CSV base in config/manifests). Narrow the requiredAnnotations setting if some do not apply to the operator.",
+		BadExample: `metadata:
+  name: my-operator.v1.2.0
+  annotations:
+    containerImage: quay.io/org/my-operator:v1.2.0`,
+		GoodExample: `metadata:
+  name: my-operator.v1.2.0
+  annotations:
+    capabilities: Seamless Upgrades
+    categories: AI/Machine Learning
+    containerImage: quay.io/org/my-operator:v1.2.0
+    createdAt: "2024-05-01T12:00:00Z"
+    support: Open Data Hub
+    description: Manages Open Data Hub components`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/adding-metadata-to-csv/",
+	}
+}
+
+func (r *CSVAnnotationsRule) requiredAnnotations() []string {
+	if len(r.RequiredAnnotations) > 0 {
+		return r.RequiredAnnotations
+	}
+	return defaultRequiredCSVAnnotations
+}
+
+func (r *CSVAnnotationsRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	return ""
+}
+
+func (r *CSVAnnotationsRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+	csv := bundle.CSV
+	annotations := csv.Metadata.Annotations
+
+	var missing []string
+	for _, key := range r.requiredAnnotations() {
+		if strings.TrimSpace(annotations[key]) == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("ClusterServiceVersion '%s' is missing annotations: %s", csv.Metadata.Name, strings.Join(missing, ", ")),
+			File:        csv.FilePath,
+			Line:        lineOf(csv.Node, "metadata", "annotations"),
+			Description: "OperatorHub shows these annotations on the operator's listing and uses them for filtering. Add them to metadata.annotations.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	if createdAt, ok := annotations["createdAt"]; ok && strings.TrimSpace(createdAt) != "" {
+		if _, err := time.Parse(time.RFC3339, createdAt); err != nil {
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    r.Severity(),
+				Message:     fmt.Sprintf("ClusterServiceVersion '%s' createdAt annotation '%s' is not an RFC 3339 timestamp", csv.Metadata.Name, createdAt),
+				File:        csv.FilePath,
+				Line:        lineOf(csv.Node, "metadata", "annotations", "createdAt"),
+				Description: "Write createdAt as an RFC 3339 timestamp, e.g. \"2024-05-01T12:00:00Z\".",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	if capabilities, ok := annotations["capabilities"]; ok && strings.TrimSpace(capabilities) != "" {
+		if !containsString(capabilityLevels, capabilities) {
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    r.Severity(),
+				Message:     fmt.Sprintf("ClusterServiceVersion '%s' capabilities annotation '%s' is not a known capability level", csv.Metadata.Name, capabilities),
+				File:        csv.FilePath,
+				Line:        lineOf(csv.Node, "metadata", "annotations", "capabilities"),
+				Description: fmt.Sprintf("Set capabilities to one of: %s.", strings.Join(capabilityLevels, ", ")),
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
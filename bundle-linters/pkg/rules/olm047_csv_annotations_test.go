@@ -0,0 +1,58 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestCSVAnnotationsRule(t *testing.T) {
+	csv := func(annotations string) map[string]string {
+		return map[string]string{"manifests/csv.yaml": `apiVersion: operators.coreos.com/v1alpha1
+kind: ClusterServiceVersion
+metadata:
+  name: example-operator.v1.0.0
+  annotations:
+` + indent(annotations, 4) + `spec:
+  version: 1.0.0
+`}
+	}
+	const complete = `capabilities: Seamless Upgrades
+categories: AI/Machine Learning
+containerImage: quay.io/example/operator:v1.0.0
+createdAt: "2024-05-01T12:00:00Z"
+support: Example
+description: Manages examples
+`
+
+	runRuleTests(t, &rules.CSVAnnotationsRule{}, []ruleTest{
+		{name: "all annotations", files: csv(complete)},
+		{
+			name:  "missing annotations",
+			files: csv("containerImage: quay.io/example/operator:v1.0.0\n"),
+			want:  []string{"ClusterServiceVersion 'example-operator.v1.0.0' is missing annotations: capabilities, categories, createdAt, support, description"},
+		},
+		{
+			name: "invalid values",
+			files: csv(`capabilities: Advanced
+categories: AI/Machine Learning
+containerImage: quay.io/example/operator:v1.0.0
+createdAt: 2024-05-01 12:00
+support: Example
+description: Manages examples
+`),
+			want: []string{
+				"createdAt annotation '2024-05-01 12:00' is not an RFC 3339 timestamp",
+				"capabilities annotation 'Advanced' is not a known capability level",
+			},
+		},
+	})
+
+	runRuleTests(t, &rules.CSVAnnotationsRule{RequiredAnnotations: []string{"containerImage", "repository"}}, []ruleTest{
+		{
+			name:  "configured annotations",
+			files: csv(complete),
+			want:  []string{"ClusterServiceVersion 'example-operator.v1.0.0' is missing annotations: repository"},
+		},
+	})
+}
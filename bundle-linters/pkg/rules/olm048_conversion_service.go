@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ODH-OLM-048: Conversion Webhook Service Not Shipped in the Bundle
+
+type ConversionServiceRule struct{}
+
+func (r *ConversionServiceRule) ID() string {
+	return "ODH-OLM-048"
+}
+
+func (r *ConversionServiceRule) Name() string {
+	return "conversion-webhook-service-missing"
+}
+
+func (r *ConversionServiceRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *ConversionServiceRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *ConversionServiceRule) Description() string {
+	return "A CRD's conversion webhook clientConfig.service must name a Service the bundle provides: a Service manifest, or the <deployment>-service OLM creates for a CSV deployment. Otherwise the API server cannot reach the webhook and every read or write of a non-storage version fails. CRDs targeted by a ConversionWebhook in the CSV's webhookdefinitions are not checked, since OLM rewrites their clientConfig."
+}
+
+func (r *ConversionServiceRule) Fixable() bool {
+	return false
+}
+
+func (r *ConversionServiceRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Declare the conversion webhook in the CSV's spec.webhookdefinitions with type ConversionWebhook so OLM creates the service and wires the CRD to it, or ship the referenced Service in the bundle.",
+		BadExample: `# CRD
+spec:
+  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+        service:
+          name: webhook-service   # no such Service in the bundle`,
+		GoodExample: `# CSV
+spec:
+  webhookdefinitions:
+  - type: ConversionWebhook
+    deploymentName: my-operator-controller-manager
+    conversionCRDs:
+    - widgets.example.com
+    webhookPath: /convert`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/adding-admission-and-conversion-webhooks/#conversion-webhooks",
+	}
+}
+
+func (r *ConversionServiceRule) SkipReason(bundle *Bundle) string {
+	if bundle.SingleManifest {
+		return skipSingleManifest
+	}
+	// Without the CSV, webhook services OLM would create are unknown
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CRDs) == 0 {
+		return skipNoCRDs
+	}
+	return ""
+}
+
+func (r *ConversionServiceRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	// OLM points CRDs listed in a ConversionWebhook definition at the
+	// service it creates, whatever their manifest says
+	managed := make(map[string]bool)
+	if bundle.CSV != nil {
+		for _, webhook := range bundle.CSV.Spec.WebhookDefinitions {
+			if webhook.Type != "ConversionWebhook" {
+				continue
+			}
+			for _, crdName := range webhook.ConversionCRDs {
+				managed[crdName] = true
+			}
+		}
+	}
+
+	for _, crd := range bundle.CRDs {
+		conversion := crd.Spec.Conversion
+		if conversion == nil || !strings.EqualFold(conversion.Strategy, "Webhook") {
+			continue
+		}
+		if conversion.Webhook == nil || conversion.Webhook.ClientConfig == nil || conversion.Webhook.ClientConfig.Service == nil {
+			continue
+		}
+		if managed[crd.Metadata.Name] {
+			continue
+		}
+
+		service := conversion.Webhook.ClientConfig.Service
+		if service.Name == "" || bundleProvidesService(bundle, service) {
+			continue
+		}
+
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("CRD '%s' conversion webhook references service '%s', which the bundle does not provide", crd.Metadata.Name, service.Name),
+			File:        crd.FilePath,
+			Line:        lineOf(crd.Node, "spec", "conversion", "webhook", "clientConfig", "service", "name"),
+			Description: "The API server sends conversion requests to this service. Declare the webhook in the CSV's webhookdefinitions, or ship a Service with this name.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
+
+// bundleProvidesService reports whether a service reference resolves to a
+// Service manifest in the bundle or to the service OLM creates for a CSV
+// deployment. A namespace on either side must match when both are set.
+func bundleProvidesService(bundle *Bundle, service *ServiceReference) bool {
+	for _, resource := range bundle.OtherResources {
+		if resource.Kind != "Service" || resource.Metadata.Name != service.Name {
+			continue
+		}
+		if service.Namespace == "" || resource.Metadata.Namespace == "" || resource.Metadata.Namespace == service.Namespace {
+			return true
+		}
+	}
+
+	if bundle.CSV != nil {
+		for _, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+			if service.Name == deployment.Name || service.Name == deployment.Name+"-service" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestConversionServiceRule(t *testing.T) {
+	const crd = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  names:
+    kind: Widget
+    plural: widgets
+  scope: Namespaced
+  conversion:
+    strategy: Webhook
+    webhook:
+      conversionReviewVersions: ["v1"]
+      clientConfig:
+        service:
+          name: widget-conversion
+          namespace: example
+          path: /convert
+  versions:
+  - name: v1
+    served: true
+    storage: true
+`
+	const service = `apiVersion: v1
+kind: Service
+metadata:
+  name: widget-conversion
+spec:
+  ports:
+  - port: 443
+`
+	pod := `containers:
+- name: manager
+  image: quay.io/example/operator:v1.0.0
+`
+	managedCSV := csvWithSpec(`version: 1.0.0
+webhookdefinitions:
+- type: ConversionWebhook
+  deploymentName: example-operator
+  generateName: cwidgets.example.com
+  admissionReviewVersions: ["v1"]
+  sideEffects: None
+  webhookPath: /convert
+  conversionCRDs:
+  - widgets.example.com
+`)
+
+	runRuleTests(t, &rules.ConversionServiceRule{}, []ruleTest{
+		{
+			name: "service shipped in the bundle",
+			files: map[string]string{
+				"manifests/csv.yaml":        csvWithPod("1", pod),
+				"manifests/widgets.yaml":    crd,
+				"manifests/conversion.yaml": service,
+			},
+		},
+		{
+			name: "webhook declared in the CSV",
+			files: map[string]string{
+				"manifests/csv.yaml":     managedCSV,
+				"manifests/widgets.yaml": crd,
+			},
+		},
+		{
+			name: "missing service",
+			files: map[string]string{
+				"manifests/csv.yaml":     csvWithPod("1", pod),
+				"manifests/widgets.yaml": crd,
+			},
+			want: []string{"CRD 'widgets.example.com' conversion webhook references service 'widget-conversion', which the bundle does not provide"},
+		},
+	})
+}
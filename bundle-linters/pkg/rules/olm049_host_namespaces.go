@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ODH-OLM-049: Install Deployment Shares Host Namespaces
+
+type HostNamespacesRule struct{}
+
+func (r *HostNamespacesRule) ID() string {
+	return "ODH-OLM-049"
+}
+
+func (r *HostNamespacesRule) Name() string {
+	return "host-namespaces"
+}
+
+func (r *HostNamespacesRule) Category() Category {
+	return CategorySecurity
+}
+
+func (r *HostNamespacesRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *HostNamespacesRule) Description() string {
+	return "Install deployments must not set hostNetwork, hostPID or hostIPC to true. Sharing the node's network, process or IPC namespace lets a compromised operator observe and interfere with everything else on the node, is rejected by baseline and restricted pod security admission, and causes port clashes when two replicas land on the same node."
+}
+
+func (r *HostNamespacesRule) Fixable() bool {
+	return false
+}
+
+func (r *HostNamespacesRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Remove hostNetwork, hostPID and hostIPC from the deployment's pod spec. Expose ports through a Service instead of the node's network.",
+		BadExample: `spec:
+  template:
+    spec:
+      hostNetwork: true
+      containers:
+      - name: manager`,
+		GoodExample: `spec:
+  template:
+    spec:
+      containers:
+      - name: manager`,
+		DocsURL: "https://kubernetes.io/docs/concepts/security/pod-security-standards/#baseline",
+	}
+}
+
+func (r *HostNamespacesRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *HostNamespacesRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for i, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		podSpec := deployment.Spec.Template.Spec
+		for _, field := range []struct {
+			name    string
+			enabled bool
+		}{
+			{"hostNetwork", podSpec.HostNetwork},
+			{"hostPID", podSpec.HostPID},
+			{"hostIPC", podSpec.HostIPC},
+		} {
+			if !field.enabled {
+				continue
+			}
+
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message:  fmt.Sprintf("Deployment '%s' sets %s: true", deployment.Name, field.name),
+				File:     bundle.CSV.FilePath,
+				Line: lineOf(bundle.CSV.Node, "spec", "install", "spec", "deployments", strconv.Itoa(i),
+					"spec", "template", "spec", field.name),
+				Description: "Sharing a host namespace exposes the node to the operator pod and is rejected by pod security admission. Remove the field from the pod spec.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,29 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestHostNamespacesRule(t *testing.T) {
+	pod := func(fields string) map[string]string {
+		return map[string]string{"manifests/csv.yaml": csvWithPod("1", fields+`containers:
+- name: manager
+  image: quay.io/example/operator:v1.0.0
+`)}
+	}
+
+	runRuleTests(t, &rules.HostNamespacesRule{}, []ruleTest{
+		{name: "no host namespaces", files: pod("")},
+		{name: "host namespaces disabled", files: pod("hostNetwork: false\nhostPID: false\n")},
+		{
+			name:  "host namespaces enabled",
+			files: pod("hostNetwork: true\nhostIPC: true\n"),
+			want: []string{
+				"Deployment 'example-operator' sets hostNetwork: true",
+				"Deployment 'example-operator' sets hostIPC: true",
+			},
+		},
+	})
+}
@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-050: Digest-Pinned Image Pulled With imagePullPolicy Always
+
+type PullPolicyAlwaysRule struct{}
+
+func (r *PullPolicyAlwaysRule) ID() string {
+	return "ODH-OLM-050"
+}
+
+func (r *PullPolicyAlwaysRule) Name() string {
+	return "pinned-image-pull-always"
+}
+
+func (r *PullPolicyAlwaysRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *PullPolicyAlwaysRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *PullPolicyAlwaysRule) Description() string {
+	return "Containers whose image is pinned by sha256 digest should not set imagePullPolicy: Always. The digest already guarantees the content, so Always only adds a registry round trip to every pod start and makes restarts fail while the registry is unreachable."
+}
+
+func (r *PullPolicyAlwaysRule) Fixable() bool {
+	return false
+}
+
+func (r *PullPolicyAlwaysRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set imagePullPolicy to IfNotPresent, or remove it: Kubernetes defaults to IfNotPresent for images without the latest tag.",
+		BadExample: `containers:
+- name: manager
+  image: quay.io/org/operator@sha256:4d6c...
+  imagePullPolicy: Always`,
+		GoodExample: `containers:
+- name: manager
+  image: quay.io/org/operator@sha256:4d6c...
+  imagePullPolicy: IfNotPresent`,
+		DocsURL: "https://kubernetes.io/docs/concepts/containers/images/#image-pull-policy",
+	}
+}
+
+func (r *PullPolicyAlwaysRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *PullPolicyAlwaysRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for i, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		for j, container := range deployment.Spec.Template.Spec.Containers {
+			image := strings.TrimSpace(container.Image)
+			if container.ImagePullPolicy != "Always" || !isDigestPinned(image) {
+				continue
+			}
+
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("Deployment '%s' container '%s' pulls digest-pinned image '%s' with imagePullPolicy Always",
+					deployment.Name, container.Name, image),
+				File: bundle.CSV.FilePath,
+				Line: lineOf(bundle.CSV.Node, "spec", "install", "spec", "deployments", strconv.Itoa(i),
+					"spec", "template", "spec", "containers", strconv.Itoa(j), "imagePullPolicy"),
+				Description: "A digest-pinned image cannot change, so pulling it on every start only adds latency and a dependency on the registry. Use IfNotPresent.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,28 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestPullPolicyAlwaysRule(t *testing.T) {
+	const digest = "quay.io/example/operator@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	pod := func(image, pullPolicy string) map[string]string {
+		return map[string]string{"manifests/csv.yaml": csvWithPod("1", `containers:
+- name: manager
+  image: `+image+`
+  imagePullPolicy: `+pullPolicy+`
+`)}
+	}
+
+	runRuleTests(t, &rules.PullPolicyAlwaysRule{}, []ruleTest{
+		{name: "digest with IfNotPresent", files: pod(digest, "IfNotPresent")},
+		{name: "tag with Always", files: pod("quay.io/example/operator:latest", "Always")},
+		{
+			name:  "digest with Always",
+			files: pod(digest, "Always"),
+			want:  []string{"Deployment 'example-operator' container 'manager' pulls digest-pinned image '" + digest + "' with imagePullPolicy Always"},
+		},
+	})
+}
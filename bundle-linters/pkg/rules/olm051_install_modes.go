@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-051: CSV installModes Incomplete or Duplicated
+
+// installModeTypes are the install modes every CSV should declare, in the
+// order operator-sdk generates them
+var installModeTypes = []string{"OwnNamespace", "SingleNamespace", "MultiNamespace", "AllNamespaces"}
+
+type InstallModesRule struct{}
+
+func (r *InstallModesRule) ID() string {
+	return "ODH-OLM-051"
+}
+
+func (r *InstallModesRule) Name() string {
+	return "install-modes-incomplete"
+}
+
+func (r *InstallModesRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *InstallModesRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *InstallModesRule) Description() string {
+	return "The CSV's spec.installModes should list each of OwnNamespace, SingleNamespace, MultiNamespace and AllNamespaces exactly once, with an explicit supported value. A type listed twice is an error, since the entries can contradict each other and OLM uses whichever it finds first. Missing types and entries without supported are warnings: OLM treats them as unsupported, which is rarely a deliberate choice."
+}
+
+func (r *InstallModesRule) Fixable() bool {
+	return false
+}
+
+func (r *InstallModesRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "List all four install modes once each and set supported to true or false for every one.",
+		BadExample: `installModes:
+- type: OwnNamespace
+  supported: true
+- type: AllNamespaces
+  supported: true
+- type: AllNamespaces
+  supported: false`,
+		GoodExample: `installModes:
+- type: OwnNamespace
+  supported: true
+- type: SingleNamespace
+  supported: true
+- type: MultiNamespace
+  supported: false
+- type: AllNamespaces
+  supported: true`,
+		DocsURL: "https://olm.operatorframework.io/docs/advanced-tasks/operator-scoping-with-operatorgroups/#installmodes",
+	}
+}
+
+func (r *InstallModesRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	return ""
+}
+
+func (r *InstallModesRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+	csv := bundle.CSV
+	modesNode := mappingPath(documentRoot(csv.Node), "spec", "installModes")
+
+	seen := make(map[string]bool)
+	for i, mode := range csv.Spec.InstallModes {
+		line := lineOf(csv.Node, "spec", "installModes", strconv.Itoa(i))
+
+		if seen[mode.Type] {
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    r.Severity(),
+				Message:     fmt.Sprintf("ClusterServiceVersion '%s' lists install mode %s more than once", csv.Metadata.Name, mode.Type),
+				File:        csv.FilePath,
+				Line:        line,
+				Description: "Duplicate install modes can contradict each other. Keep a single entry for each type.",
+				Fixable:     r.Fixable(),
+			})
+			continue
+		}
+		seen[mode.Type] = true
+
+		if modesNode != nil && i < len(modesNode.Content) && mappingValue(modesNode.Content[i], "supported") == nil {
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    SeverityWarning,
+				Message:     fmt.Sprintf("ClusterServiceVersion '%s' install mode %s does not set supported", csv.Metadata.Name, mode.Type),
+				File:        csv.FilePath,
+				Line:        line,
+				Description: "An install mode without supported is treated as unsupported. Set supported to true or false.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	var missing []string
+	for _, modeType := range installModeTypes {
+		if !seen[modeType] {
+			missing = append(missing, modeType)
+		}
+	}
+	if len(missing) > 0 {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    SeverityWarning,
+			Message:     fmt.Sprintf("ClusterServiceVersion '%s' installModes is missing %s", csv.Metadata.Name, strings.Join(missing, ", ")),
+			File:        csv.FilePath,
+			Line:        lineOf(csv.Node, "spec", "installModes"),
+			Description: "OLM treats a missing install mode as unsupported. List all four install modes with an explicit supported value.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,54 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestInstallModesRule(t *testing.T) {
+	csv := func(installModes string) map[string]string {
+		return map[string]string{"manifests/csv.yaml": csvWithSpec("version: 1.0.0\ninstallModes:\n" + installModes)}
+	}
+
+	runRuleTests(t, &rules.InstallModesRule{}, []ruleTest{
+		{
+			name: "all four modes",
+			files: csv(`- type: OwnNamespace
+  supported: true
+- type: SingleNamespace
+  supported: true
+- type: MultiNamespace
+  supported: false
+- type: AllNamespaces
+  supported: true
+`),
+		},
+		{
+			name: "duplicated mode",
+			files: csv(`- type: OwnNamespace
+  supported: true
+- type: SingleNamespace
+  supported: true
+- type: MultiNamespace
+  supported: false
+- type: AllNamespaces
+  supported: true
+- type: AllNamespaces
+  supported: false
+`),
+			want: []string{"ClusterServiceVersion 'example-operator.v1.0.0' lists install mode AllNamespaces more than once"},
+		},
+		{
+			name: "missing modes and supported",
+			files: csv(`- type: OwnNamespace
+  supported: true
+- type: AllNamespaces
+`),
+			want: []string{
+				"ClusterServiceVersion 'example-operator.v1.0.0' install mode AllNamespaces does not set supported",
+				"ClusterServiceVersion 'example-operator.v1.0.0' installModes is missing SingleNamespace, MultiNamespace",
+			},
+		},
+	})
+}
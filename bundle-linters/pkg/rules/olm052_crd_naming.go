@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-052: Inconsistent CRD Names or Owned CRD Kind
+
+type CRDNamingRule struct{}
+
+func (r *CRDNamingRule) ID() string {
+	return "ODH-OLM-052"
+}
+
+func (r *CRDNamingRule) Name() string {
+	return "crd-naming-consistency"
+}
+
+func (r *CRDNamingRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *CRDNamingRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *CRDNamingRule) Description() string {
+	return "An owned CRD reference in the CSV must use the kind the CRD declares in spec.names.kind; otherwise OLM cannot match the CSV's API description to the installed resource. Within a CRD, names.singular should be the lowercased kind and names.plural a regular pluralization of it; mismatches there are warnings, since they confuse kubectl users rather than break the install."
+}
+
+func (r *CRDNamingRule) Fixable() bool {
+	return false
+}
+
+func (r *CRDNamingRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Make the owned entry's kind match the CRD's spec.names.kind, set names.singular to the lowercased kind and names.plural to its plural (kubebuilder generates these from the Go type name).",
+		BadExample: `# CRD
+spec:
+  names:
+    kind: Widget
+    singular: gizmo
+    plural: widgetz
+# CSV
+customresourcedefinitions:
+  owned:
+  - name: widgetz.example.com
+    kind: Gadget`,
+		GoodExample: `# CRD
+spec:
+  names:
+    kind: Widget
+    singular: widget
+    plural: widgets
+# CSV
+customresourcedefinitions:
+  owned:
+  - name: widgets.example.com
+    kind: Widget`,
+		DocsURL: "https://kubernetes.io/docs/tasks/extend-kubernetes/custom-resources/custom-resource-definitions/#create-a-customresourcedefinition",
+	}
+}
+
+func (r *CRDNamingRule) SkipReason(bundle *Bundle) string {
+	if len(bundle.CRDs) == 0 {
+		return skipNoCRDs
+	}
+	return ""
+}
+
+func (r *CRDNamingRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	crds := make(map[string]*CustomResourceDefinition)
+	for _, crd := range bundle.CRDs {
+		crds[crd.Metadata.Name] = crd
+
+		names := crd.Spec.Names
+		if names.Kind == "" {
+			continue
+		}
+		lowerKind := strings.ToLower(names.Kind)
+
+		if names.Singular != "" && names.Singular != lowerKind {
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    SeverityWarning,
+				Message:     fmt.Sprintf("CRD '%s' names.singular '%s' is not the lowercased kind '%s'", crd.Metadata.Name, names.Singular, lowerKind),
+				File:        crd.FilePath,
+				Line:        lineOf(crd.Node, "spec", "names", "singular"),
+				Description: fmt.Sprintf("kubectl accepts the singular name in place of the kind. Set names.singular to '%s'.", lowerKind),
+				Fixable:     r.Fixable(),
+			})
+		}
+
+		if names.Plural != "" && !isPluralOf(names.Plural, lowerKind) {
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    SeverityWarning,
+				Message:     fmt.Sprintf("CRD '%s' names.plural '%s' is not a plural of kind '%s'", crd.Metadata.Name, names.Plural, names.Kind),
+				File:        crd.FilePath,
+				Line:        lineOf(crd.Node, "spec", "names", "plural"),
+				Description: "Users type the plural in 'kubectl get'. Use the lowercased plural of the kind, e.g. 'widgets' for Widget or 'policies' for Policy.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	if bundle.CSV == nil {
+		return violations
+	}
+	csv := bundle.CSV
+
+	for i, ref := range csv.Spec.CustomResourceDefinitions.Owned {
+		crd, ok := crds[ref.Name]
+		if !ok || ref.Kind == "" || crd.Spec.Names.Kind == "" || ref.Kind == crd.Spec.Names.Kind {
+			continue
+		}
+
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("Owned CRD '%s' has kind '%s' in the CSV, but the CRD declares kind '%s'", ref.Name, ref.Kind, crd.Spec.Names.Kind),
+			File:        csv.FilePath,
+			Line:        lineOf(csv.Node, "spec", "customresourcedefinitions", "owned", strconv.Itoa(i), "kind"),
+			Description: "OLM matches the CSV's owned API descriptions to CRDs by kind. Use the kind from the CRD's spec.names.kind.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
+
+// isPluralOf reports whether plural is a regular English plural of the
+// lowercased kind: kind+s, kind+es, y to ies, or the kind itself for
+// uncountable nouns such as data
+func isPluralOf(plural, lowerKind string) bool {
+	switch plural {
+	case lowerKind, lowerKind + "s", lowerKind + "es":
+		return true
+	}
+	if stem, ok := strings.CutSuffix(lowerKind, "y"); ok && plural == stem+"ies" {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestCRDNamingRule(t *testing.T) {
+	crd := func(kind, singular, plural string) string {
+		return `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: ` + plural + `.example.com
+spec:
+  group: example.com
+  names:
+    kind: ` + kind + `
+    listKind: ` + kind + `List
+    singular: ` + singular + `
+    plural: ` + plural + `
+  scope: Namespaced
+  versions:
+  - name: v1
+    served: true
+    storage: true
+`
+	}
+	csv := func(kind string) string {
+		return csvWithSpec(`version: 1.0.0
+customresourcedefinitions:
+  owned:
+  - name: widgets.example.com
+    version: v1
+    kind: ` + kind + `
+`)
+	}
+
+	runRuleTests(t, &rules.CRDNamingRule{}, []ruleTest{
+		{
+			name: "regular names",
+			files: map[string]string{
+				"manifests/widgets.crd.yaml":  crd("Widget", "widget", "widgets"),
+				"manifests/policies.crd.yaml": crd("Policy", "policy", "policies"),
+				"manifests/classes.crd.yaml":  crd("Class", "class", "classes"),
+				"manifests/csv.yaml":          csv("Widget"),
+			},
+		},
+		{
+			name:  "singular is not the kind",
+			files: map[string]string{"manifests/widgets.crd.yaml": crd("Widget", "widgets", "widgets")},
+			want:  []string{"CRD 'widgets.example.com' names.singular 'widgets' is not the lowercased kind 'widget'"},
+		},
+		{
+			name:  "plural is not a plural of the kind",
+			files: map[string]string{"manifests/gizmos.crd.yaml": crd("Widget", "widget", "gizmos")},
+			want:  []string{"CRD 'gizmos.example.com' names.plural 'gizmos' is not a plural of kind 'Widget'"},
+		},
+		{
+			name: "CSV kind differs from the CRD",
+			files: map[string]string{
+				"manifests/widgets.crd.yaml": crd("Widget", "widget", "widgets"),
+				"manifests/csv.yaml":         csv("Gadget"),
+			},
+			want: []string{"Owned CRD 'widgets.example.com' has kind 'Gadget' in the CSV, but the CRD declares kind 'Widget'"},
+		},
+	})
+}
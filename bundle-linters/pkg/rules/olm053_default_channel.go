@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ODH-OLM-053: Default Channel Not Among the Bundle's Channels
+
+const defaultChannelAnnotation = "operators.operatorframework.io.bundle.channel.default.v1"
+
+type DefaultChannelRule struct{}
+
+func (r *DefaultChannelRule) ID() string {
+	return "ODH-OLM-053"
+}
+
+func (r *DefaultChannelRule) Name() string {
+	return "default-channel-not-listed"
+}
+
+func (r *DefaultChannelRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *DefaultChannelRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *DefaultChannelRule) Description() string {
+	return "When metadata/annotations.yaml sets a default channel, it must be one of the channels the bundle lists in channels.v1. Otherwise the catalog's default channel has no bundle in it, and subscriptions that rely on the default fail to resolve. A default channel with no channel list at all is a warning."
+}
+
+func (r *DefaultChannelRule) Fixable() bool {
+	return false
+}
+
+func (r *DefaultChannelRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Add the default channel to operators.operatorframework.io.bundle.channels.v1, or set the default to one of the listed channels (e.g. with operator-sdk generate bundle --channels and --default-channel).",
+		BadExample: `annotations:
+  operators.operatorframework.io.bundle.channels.v1: fast,candidate
+  operators.operatorframework.io.bundle.channel.default.v1: stable`,
+		GoodExample: `annotations:
+  operators.operatorframework.io.bundle.channels.v1: stable,fast
+  operators.operatorframework.io.bundle.channel.default.v1: stable`,
+		DocsURL: "https://olm.operatorframework.io/docs/best-practices/channel-naming/",
+	}
+}
+
+func (r *DefaultChannelRule) SkipReason(bundle *Bundle) string {
+	if bundle.Annotations == nil {
+		return skipNoAnnotations
+	}
+	if strings.TrimSpace(bundle.Annotations.DefaultChannel) == "" {
+		return "the bundle annotations set no default channel"
+	}
+	return ""
+}
+
+func (r *DefaultChannelRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	annotations := bundle.Annotations
+	if annotations == nil {
+		return violations
+	}
+	defaultChannel := strings.TrimSpace(annotations.DefaultChannel)
+	if defaultChannel == "" {
+		return violations
+	}
+	line := lineOf(annotations.Node, "annotations", defaultChannelAnnotation)
+
+	if len(annotations.Channels) == 0 {
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    SeverityWarning,
+			Message:     fmt.Sprintf("Default channel '%s' is set, but the bundle lists no channels", defaultChannel),
+			File:        annotations.FilePath,
+			Line:        line,
+			Description: "A default channel only makes sense alongside the channel list. Set operators.operatorframework.io.bundle.channels.v1 and include the default in it.",
+			Fixable:     r.Fixable(),
+		})
+		return violations
+	}
+
+	if !containsString(annotations.Channels, defaultChannel) {
+		violations = append(violations, Violation{
+			RuleID:   r.ID(),
+			RuleName: r.Name(),
+			Category: r.Category(),
+			Severity: r.Severity(),
+			Message: fmt.Sprintf("Default channel '%s' is not one of the bundle's channels [%s]",
+				defaultChannel, strings.Join(annotations.Channels, ", ")),
+			File:        annotations.FilePath,
+			Line:        line,
+			Description: "The catalog's default channel would contain no bundle. Add the default channel to channels.v1 or pick one of the listed channels as the default.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,40 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestDefaultChannelRule(t *testing.T) {
+	annotations := func(channels, defaultChannel string) map[string]string {
+		content := `annotations:
+  operators.operatorframework.io.bundle.mediatype.v1: registry+v1
+  operators.operatorframework.io.bundle.manifests.v1: manifests/
+  operators.operatorframework.io.bundle.metadata.v1: metadata/
+  operators.operatorframework.io.bundle.package.v1: example-operator
+`
+		if channels != "" {
+			content += "  operators.operatorframework.io.bundle.channels.v1: " + channels + "\n"
+		}
+		if defaultChannel != "" {
+			content += "  operators.operatorframework.io.bundle.channel.default.v1: " + defaultChannel + "\n"
+		}
+		return map[string]string{"metadata/annotations.yaml": content}
+	}
+
+	runRuleTests(t, &rules.DefaultChannelRule{}, []ruleTest{
+		{name: "default among the channels", files: annotations("stable,fast", "fast")},
+		{name: "no default channel", files: annotations("stable", "")},
+		{
+			name:  "default not among the channels",
+			files: annotations("stable,fast", "candidate"),
+			want:  []string{"Default channel 'candidate' is not one of the bundle's channels [stable, fast]"},
+		},
+		{
+			name:  "default without channels",
+			files: annotations("", "stable"),
+			want:  []string{"Default channel 'stable' is set, but the bundle lists no channels"},
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-054: emptyDir Volume Without sizeLimit
+
+type EmptyDirSizeLimitRule struct{}
+
+func (r *EmptyDirSizeLimitRule) ID() string {
+	return "ODH-OLM-054"
+}
+
+func (r *EmptyDirSizeLimitRule) Name() string {
+	return "emptydir-without-sizelimit"
+}
+
+func (r *EmptyDirSizeLimitRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *EmptyDirSizeLimitRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *EmptyDirSizeLimitRule) Description() string {
+	return "emptyDir volumes in the CSV's install deployments should set sizeLimit. An unbounded emptyDir can fill the node's ephemeral storage (or, with medium: Memory, count without limit against the pod's memory), which gets the operator, and possibly its neighbours, evicted."
+}
+
+func (r *EmptyDirSizeLimitRule) Fixable() bool {
+	return false
+}
+
+func (r *EmptyDirSizeLimitRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set emptyDir.sizeLimit to the most the operator needs, and consider an ephemeral-storage limit on the container.",
+		BadExample: `volumes:
+- name: cache
+  emptyDir: {}`,
+		GoodExample: `volumes:
+- name: cache
+  emptyDir:
+    sizeLimit: 500Mi`,
+		DocsURL: "https://kubernetes.io/docs/concepts/storage/volumes/#emptydir",
+	}
+}
+
+func (r *EmptyDirSizeLimitRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *EmptyDirSizeLimitRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for i, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		for j, volume := range deployment.Spec.Template.Spec.Volumes {
+			if volume.EmptyDir == nil || strings.TrimSpace(volume.EmptyDir.SizeLimit) != "" {
+				continue
+			}
+
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("Deployment '%s' emptyDir volume '%s' has no sizeLimit",
+					deployment.Name, volume.Name),
+				File: bundle.CSV.FilePath,
+				Line: lineOf(bundle.CSV.Node, "spec", "install", "spec", "deployments", strconv.Itoa(i),
+					"spec", "template", "spec", "volumes", strconv.Itoa(j), "emptyDir"),
+				Description: "An unbounded emptyDir can exhaust the node's ephemeral storage and get the operator evicted. Set emptyDir.sizeLimit.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,43 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestEmptyDirSizeLimitRule(t *testing.T) {
+	pod := func(volumes string) string {
+		return `containers:
+- name: manager
+  image: quay.io/example/operator:v1.0.0
+volumes:
+` + volumes
+	}
+
+	runRuleTests(t, &rules.EmptyDirSizeLimitRule{}, []ruleTest{
+		{
+			name: "bounded emptyDir",
+			files: map[string]string{"manifests/csv.yaml": csvWithPod("1", pod(`- name: cache
+  emptyDir:
+    sizeLimit: 1Gi
+- name: config
+  configMap:
+    name: example-config
+`))},
+		},
+		{
+			name: "unbounded emptyDir",
+			files: map[string]string{"manifests/csv.yaml": csvWithPod("1", pod(`- name: cache
+  emptyDir: {}
+- name: scratch
+  emptyDir:
+    medium: Memory
+`))},
+			want: []string{
+				"Deployment 'example-operator' emptyDir volume 'cache' has no sizeLimit",
+				"Deployment 'example-operator' emptyDir volume 'scratch' has no sizeLimit",
+			},
+		},
+	})
+}
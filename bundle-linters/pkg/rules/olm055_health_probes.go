@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ODH-OLM-055: Operator Container Without Health Probes
+
+type HealthProbesRule struct{}
+
+func (r *HealthProbesRule) ID() string {
+	return "ODH-OLM-055"
+}
+
+func (r *HealthProbesRule) Name() string {
+	return "missing-health-probes"
+}
+
+func (r *HealthProbesRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *HealthProbesRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *HealthProbesRule) Description() string {
+	return "Every container in the CSV's install deployments should define a livenessProbe and a readinessProbe. Without a liveness probe a wedged operator is never restarted; without a readiness probe its webhooks and metrics receive traffic before it can serve them. Each missing probe is reported separately."
+}
+
+func (r *HealthProbesRule) Fixable() bool {
+	return false
+}
+
+func (r *HealthProbesRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Serve health endpoints from the manager (controller-runtime's --health-probe-bind-address with healthz and readyz checks) and point the container's probes at them.",
+		BadExample: `containers:
+- name: manager
+  image: quay.io/org/operator:v1.0.0`,
+		GoodExample: `containers:
+- name: manager
+  image: quay.io/org/operator:v1.0.0
+  livenessProbe:
+    httpGet:
+      path: /healthz
+      port: 8081
+  readinessProbe:
+    httpGet:
+      path: /readyz
+      port: 8081`,
+		DocsURL: "https://kubernetes.io/docs/tasks/configure-pod-container/configure-liveness-readiness-startup-probes/",
+	}
+}
+
+func (r *HealthProbesRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *HealthProbesRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for i, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		for j, container := range deployment.Spec.Template.Spec.Containers {
+			line := lineOf(bundle.CSV.Node, "spec", "install", "spec", "deployments", strconv.Itoa(i),
+				"spec", "template", "spec", "containers", strconv.Itoa(j))
+
+			for _, probe := range []struct {
+				name    string
+				defined bool
+				why     string
+			}{
+				{"livenessProbe", container.LivenessProbe, "Without a liveness probe the kubelet cannot restart a wedged operator."},
+				{"readinessProbe", container.ReadinessProbe, "Without a readiness probe the pod receives traffic before it can serve it."},
+			} {
+				if probe.defined {
+					continue
+				}
+
+				violations = append(violations, Violation{
+					RuleID:      r.ID(),
+					RuleName:    r.Name(),
+					Category:    r.Category(),
+					Severity:    r.Severity(),
+					Message:     fmt.Sprintf("Deployment '%s' container '%s' has no %s", deployment.Name, container.Name, probe.name),
+					File:        bundle.CSV.FilePath,
+					Line:        line,
+					Description: probe.why + " Define " + probe.name + " on the container.",
+					Fixable:     r.Fixable(),
+				})
+			}
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,45 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestHealthProbesRule(t *testing.T) {
+	runRuleTests(t, &rules.HealthProbesRule{}, []ruleTest{
+		{
+			name: "both probes",
+			files: map[string]string{"manifests/csv.yaml": csvWithPod("1", `containers:
+- name: manager
+  image: quay.io/example/operator:v1.0.0
+  livenessProbe:
+    httpGet:
+      path: /healthz
+      port: 8081
+  readinessProbe:
+    httpGet:
+      path: /readyz
+      port: 8081
+`)},
+		},
+		{
+			name: "missing probes",
+			files: map[string]string{"manifests/csv.yaml": csvWithPod("1", `containers:
+- name: manager
+  image: quay.io/example/operator:v1.0.0
+  livenessProbe:
+    httpGet:
+      path: /healthz
+      port: 8081
+- name: kube-rbac-proxy
+  image: quay.io/example/kube-rbac-proxy:v1.0.0
+`)},
+			want: []string{
+				"Deployment 'example-operator' container 'manager' has no readinessProbe",
+				"Deployment 'example-operator' container 'kube-rbac-proxy' has no livenessProbe",
+				"Deployment 'example-operator' container 'kube-rbac-proxy' has no readinessProbe",
+			},
+		},
+	})
+}
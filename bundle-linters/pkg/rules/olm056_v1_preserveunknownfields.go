@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ODH-OLM-056: apiextensions.k8s.io/v1 CRD with PreserveUnknownFields=true
+
+type V1PreserveUnknownFieldsRule struct{}
+
+func (r *V1PreserveUnknownFieldsRule) ID() string {
+	return "ODH-OLM-056"
+}
+
+func (r *V1PreserveUnknownFieldsRule) Name() string {
+	return "v1-crd-preserve-unknown-fields"
+}
+
+func (r *V1PreserveUnknownFieldsRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *V1PreserveUnknownFieldsRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *V1PreserveUnknownFieldsRule) Description() string {
+	return "apiextensions.k8s.io/v1 CRDs must not set spec.preserveUnknownFields to true, whether or not a conversion webhook serves them. The API server rejects such CRDs, since v1 requires structural schemas; unknown fields are kept with x-kubernetes-preserve-unknown-fields in the schema instead. v1beta1 CRDs, where the field is still allowed, are not checked."
+}
+
+func (r *V1PreserveUnknownFieldsRule) Fixable() bool {
+	return true // Can be auto-fixed by setting to false
+}
+
+func (r *V1PreserveUnknownFieldsRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set spec.preserveUnknownFields to false (or remove it), and mark the schema nodes that must keep unknown fields with x-kubernetes-preserve-unknown-fields: true.",
+		BadExample: `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+spec:
+  preserveUnknownFields: true`,
+		GoodExample: `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+spec:
+  preserveUnknownFields: false`,
+		DocsURL: "https://kubernetes.io/docs/tasks/extend-kubernetes/custom-resources/custom-resource-definitions/#field-pruning",
+	}
+}
+
+// Fix sets spec.preserveUnknownFields to false on the flagged v1 CRD
+func (r *V1PreserveUnknownFieldsRule) Fix(doc *yaml.Node, violation Violation) (bool, error) {
+	if documentKind(doc) != "CustomResourceDefinition" {
+		return false, nil
+	}
+
+	apiVersion := mappingValue(documentRoot(doc), "apiVersion")
+	if apiVersion == nil || apiVersion.Value != "apiextensions.k8s.io/v1" {
+		return false, nil
+	}
+
+	node := mappingPath(documentRoot(doc), "spec", "preserveUnknownFields")
+	if node == nil || node.Kind != yaml.ScalarNode || !isTrueValue(node.Value) {
+		return false, nil
+	}
+	return setBoolValue(node, false), nil
+}
+
+func (r *V1PreserveUnknownFieldsRule) SkipReason(bundle *Bundle) string {
+	if len(bundle.CRDs) == 0 {
+		return skipNoCRDs
+	}
+	return ""
+}
+
+func (r *V1PreserveUnknownFieldsRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	for _, crd := range bundle.CRDs {
+		if crd.APIVersion != "apiextensions.k8s.io/v1" {
+			continue
+		}
+		if crd.Spec.PreserveUnknownFields == nil || !*crd.Spec.PreserveUnknownFields {
+			continue
+		}
+
+		violations = append(violations, Violation{
+			RuleID:      r.ID(),
+			RuleName:    r.Name(),
+			Category:    r.Category(),
+			Severity:    r.Severity(),
+			Message:     fmt.Sprintf("CRD '%s' is apiextensions.k8s.io/v1 but has preserveUnknownFields=true", crd.Metadata.Name),
+			File:        crd.FilePath,
+			Line:        lineOf(crd.Node, "spec", "preserveUnknownFields"),
+			Description: "apiextensions.k8s.io/v1 does not allow preserveUnknownFields=true. Set it to false and use x-kubernetes-preserve-unknown-fields in the schema where needed.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
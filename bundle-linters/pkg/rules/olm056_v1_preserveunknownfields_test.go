@@ -0,0 +1,49 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestV1PreserveUnknownFieldsRule(t *testing.T) {
+	crd := func(apiVersion, preserve string) string {
+		return `apiVersion: ` + apiVersion + `
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  scope: Namespaced
+` + preserve + `
+  names:
+    kind: Widget
+    plural: widgets
+  versions:
+  - name: v1
+    served: true
+    storage: true
+`
+	}
+
+	runRuleTests(t, &rules.V1PreserveUnknownFieldsRule{}, []ruleTest{
+		{
+			name:  "unset",
+			files: map[string]string{"manifests/widgets.crd.yaml": crd("apiextensions.k8s.io/v1", "")},
+		},
+		{
+			name:  "false",
+			files: map[string]string{"manifests/widgets.crd.yaml": crd("apiextensions.k8s.io/v1", "  preserveUnknownFields: false")},
+		},
+		{
+			// Only v1 CRDs must disable it; v1beta1 is reported as removed
+			name:  "v1beta1",
+			files: map[string]string{"manifests/widgets.crd.yaml": crd("apiextensions.k8s.io/v1beta1", "  preserveUnknownFields: true")},
+		},
+		{
+			name:  "true on a v1 CRD",
+			files: map[string]string{"manifests/widgets.crd.yaml": crd("apiextensions.k8s.io/v1", "  preserveUnknownFields: true")},
+			want:  []string{"CRD 'widgets.example.com' is apiextensions.k8s.io/v1 but has preserveUnknownFields=true"},
+		},
+	})
+}
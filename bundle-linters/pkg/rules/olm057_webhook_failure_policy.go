@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-057: Fail-Closed Webhook Intercepting Core Resources
+
+var defaultCriticalResources = []string{"pods", "nodes", "namespaces", "services", "endpoints", "configmaps", "secrets", "serviceaccounts", "events"}
+
+type WebhookFailurePolicyRule struct {
+	// CriticalResources are the core ("") group resources a fail-closed
+	// webhook should not intercept, replacing the default list
+	CriticalResources []string `yaml:"criticalResources"`
+}
+
+func (r *WebhookFailurePolicyRule) ID() string {
+	return "ODH-OLM-057"
+}
+
+func (r *WebhookFailurePolicyRule) Name() string {
+	return "webhook-fail-closed-core-resources"
+}
+
+func (r *WebhookFailurePolicyRule) Category() Category {
+	return CategoryOLMBestPractice
+}
+
+func (r *WebhookFailurePolicyRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *WebhookFailurePolicyRule) Description() string {
+	return "An admission webhook with failurePolicy Fail (the default when unset) that intercepts core resources such as pods, nodes or namespaces blocks those requests cluster-wide whenever the operator's webhook server is down, which can keep the operator itself from being rescheduled. The resources treated as critical can be changed with the criticalResources setting."
+}
+
+func (r *WebhookFailurePolicyRule) Fixable() bool {
+	return false
+}
+
+func (r *WebhookFailurePolicyRule) Configure(settings map[string]interface{}) error {
+	return decodeSettings(settings, r)
+}
+
+func (r *WebhookFailurePolicyRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Set failurePolicy: Ignore on webhooks that intercept core resources, or narrow their rules to the operator's own API groups, and scope them further with objectSelector or namespaceSelector where the webhook only cares about some objects.",
+		BadExample: `webhookdefinitions:
+- type: MutatingAdmissionWebhook
+  failurePolicy: Fail
+  rules:
+  - apiGroups: [""]
+    resources: ["pods"]
+    operations: ["CREATE"]`,
+		GoodExample: `webhookdefinitions:
+- type: MutatingAdmissionWebhook
+  failurePolicy: Ignore
+  rules:
+  - apiGroups: [""]
+    resources: ["pods"]
+    operations: ["CREATE"]`,
+		DocsURL: "https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#failure-policy",
+	}
+}
+
+func (r *WebhookFailurePolicyRule) criticalResources() []string {
+	if len(r.CriticalResources) > 0 {
+		return r.CriticalResources
+	}
+	return defaultCriticalResources
+}
+
+// interceptedCoreResources returns the critical core resources a webhook
+// rule matches. A '*' resource matches all of them, and subresources such
+// as pods/exec count as their parent resource.
+func (r *WebhookFailurePolicyRule) interceptedCoreResources(rule WebhookRule) []string {
+	if !containsString(rule.APIGroups, "") && !containsString(rule.APIGroups, "*") {
+		return nil
+	}
+
+	var matched []string
+	for _, resource := range rule.Resources {
+		if resource == "*" || strings.HasPrefix(resource, "*/") {
+			return []string{"*"}
+		}
+		parent, _, _ := strings.Cut(strings.ToLower(resource), "/")
+		if containsString(r.criticalResources(), parent) && !containsString(matched, parent) {
+			matched = append(matched, parent)
+		}
+	}
+	return matched
+}
+
+func (r *WebhookFailurePolicyRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if !csvHasWebhookType(bundle.CSV, "ValidatingAdmissionWebhook") && !csvHasWebhookType(bundle.CSV, "MutatingAdmissionWebhook") {
+		return "the CSV defines no admission webhooks"
+	}
+	return ""
+}
+
+func (r *WebhookFailurePolicyRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for i, webhook := range bundle.CSV.Spec.WebhookDefinitions {
+		if webhook.Type == "ConversionWebhook" {
+			continue
+		}
+		if webhook.FailurePolicy != "" && webhook.FailurePolicy != "Fail" {
+			continue
+		}
+
+		var intercepted []string
+		for _, rule := range webhook.Rules {
+			for _, resource := range r.interceptedCoreResources(rule) {
+				if !containsString(intercepted, resource) {
+					intercepted = append(intercepted, resource)
+				}
+			}
+		}
+		if len(intercepted) == 0 {
+			continue
+		}
+
+		policy := "failurePolicy Fail"
+		if webhook.FailurePolicy == "" {
+			policy = "the default failurePolicy Fail"
+		}
+
+		violations = append(violations, Violation{
+			RuleID:   r.ID(),
+			RuleName: r.Name(),
+			Category: r.Category(),
+			Severity: r.Severity(),
+			Message: fmt.Sprintf("Webhook '%s' (%s) uses %s and intercepts core resources [%s]; use failurePolicy Ignore or narrow its rules",
+				webhook.GenerateName, webhook.Type, policy, strings.Join(intercepted, ", ")),
+			File:        bundle.CSV.FilePath,
+			Line:        lineOf(bundle.CSV.Node, "spec", "webhookdefinitions", strconv.Itoa(i), "failurePolicy"),
+			Description: "While the webhook server is unavailable, every matching request is rejected, which can wedge the cluster. Set failurePolicy: Ignore or limit the rules to the operator's own resources.",
+			Fixable:     r.Fixable(),
+		})
+	}
+
+	return violations
+}
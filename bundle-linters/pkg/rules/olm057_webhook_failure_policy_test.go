@@ -0,0 +1,55 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestWebhookFailurePolicyRule(t *testing.T) {
+	webhook := func(failurePolicy, apiGroups, resources string) string {
+		return csvWithSpec(`version: 1.0.0
+webhookdefinitions:
+- type: ValidatingAdmissionWebhook
+  generateName: vexample.example.com
+  deploymentName: example-operator
+  admissionReviewVersions: [v1]
+  sideEffects: None
+` + failurePolicy + `
+  rules:
+  - apiGroups: ` + apiGroups + `
+    apiVersions: ["*"]
+    operations: [CREATE, UPDATE]
+    resources: ` + resources + `
+`)
+	}
+
+	runRuleTests(t, &rules.WebhookFailurePolicyRule{}, []ruleTest{
+		{
+			name:  "own resources, fail closed",
+			files: map[string]string{"manifests/csv.yaml": webhook("  failurePolicy: Fail", `["example.com"]`, `["widgets"]`)},
+		},
+		{
+			name:  "core resources, fail open",
+			files: map[string]string{"manifests/csv.yaml": webhook("  failurePolicy: Ignore", `[""]`, `["pods"]`)},
+		},
+		{
+			name:  "core resources, fail closed",
+			files: map[string]string{"manifests/csv.yaml": webhook("  failurePolicy: Fail", `[""]`, `["pods", "pods/exec", "configmaps"]`)},
+			want:  []string{"Webhook 'vexample.example.com' (ValidatingAdmissionWebhook) uses failurePolicy Fail and intercepts core resources [pods, configmaps]"},
+		},
+		{
+			name:  "all resources, default policy",
+			files: map[string]string{"manifests/csv.yaml": webhook("", `["*"]`, `["*"]`)},
+			want:  []string{"uses the default failurePolicy Fail and intercepts core resources [*]"},
+		},
+	})
+
+	runRuleTests(t, &rules.WebhookFailurePolicyRule{CriticalResources: []string{"widgets"}}, []ruleTest{
+		{
+			name:  "configured critical resources",
+			files: map[string]string{"manifests/csv.yaml": webhook("  failurePolicy: Fail", `[""]`, `["pods", "widgets"]`)},
+			want:  []string{"intercepts core resources [widgets]"},
+		},
+	})
+}
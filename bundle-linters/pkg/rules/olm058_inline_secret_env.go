@@ -0,0 +1,149 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ODH-OLM-058: Credential Set Inline in a Container Environment Variable
+
+// defaultSensitiveEnvPatterns match environment variable names that
+// usually hold credentials
+var defaultSensitiveEnvPatterns = []string{".*PASSWORD.*", ".*TOKEN.*", ".*SECRET.*", ".*KEY.*"}
+
+type InlineSecretEnvRule struct {
+	// SensitivePatterns are regular expressions matched against the whole
+	// variable name, ignoring case, replacing the default list
+	SensitivePatterns []string `yaml:"sensitivePatterns"`
+}
+
+func (r *InlineSecretEnvRule) ID() string {
+	return "ODH-OLM-058"
+}
+
+func (r *InlineSecretEnvRule) Name() string {
+	return "env-inline-secret"
+}
+
+func (r *InlineSecretEnvRule) Category() Category {
+	return CategorySecurity
+}
+
+func (r *InlineSecretEnvRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *InlineSecretEnvRule) Description() string {
+	return "Environment variables in the CSV's install deployments whose names suggest a credential (containing PASSWORD, TOKEN, SECRET or KEY) should be set from a Secret with valueFrom.secretKeyRef, not with an inline value. Inline values are published in the bundle image and the catalog, and are readable by anyone who can read the Deployment. The name patterns can be changed with the sensitivePatterns setting."
+}
+
+func (r *InlineSecretEnvRule) Fixable() bool {
+	return false
+}
+
+func (r *InlineSecretEnvRule) Configure(settings map[string]interface{}) error {
+	if err := decodeSettings(settings, r); err != nil {
+		return err
+	}
+	for _, pattern := range r.SensitivePatterns {
+		if _, err := compileEnvPattern(pattern); err != nil {
+			return fmt.Errorf("invalid sensitivePatterns entry %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+func (r *InlineSecretEnvRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Store the value in a Secret and reference it with valueFrom.secretKeyRef. If the Secret is not shipped in the bundle, document that it must exist in the install namespace.",
+		BadExample: `env:
+- name: DB_PASSWORD
+  value: hunter2`,
+		GoodExample: `env:
+- name: DB_PASSWORD
+  valueFrom:
+    secretKeyRef:
+      name: db-credentials
+      key: password`,
+		DocsURL: "https://kubernetes.io/docs/tasks/inject-data-application/distribute-credentials-secure/#define-container-environment-variables-using-secret-data",
+	}
+}
+
+// compileEnvPattern compiles a sensitive name pattern so that it must
+// match the whole name, ignoring case
+func compileEnvPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("(?i)^(?:" + pattern + ")$")
+}
+
+func (r *InlineSecretEnvRule) sensitivePatterns() []*regexp.Regexp {
+	patterns := defaultSensitiveEnvPatterns
+	if len(r.SensitivePatterns) > 0 {
+		patterns = r.SensitivePatterns
+	}
+
+	var compiled []*regexp.Regexp
+	for _, pattern := range patterns {
+		// Configure rejects invalid patterns, so errors cannot occur here
+		if re, err := compileEnvPattern(pattern); err == nil {
+			compiled = append(compiled, re)
+		}
+	}
+	return compiled
+}
+
+func (r *InlineSecretEnvRule) SkipReason(bundle *Bundle) string {
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if len(bundle.CSV.Spec.Install.Spec.Deployments) == 0 {
+		return skipNoDeployments
+	}
+	return ""
+}
+
+func (r *InlineSecretEnvRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+	patterns := r.sensitivePatterns()
+
+	for i, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		for j, container := range deployment.Spec.Template.Spec.Containers {
+			for k, env := range container.Env {
+				if strings.TrimSpace(env.Value) == "" || !matchesAnyPattern(patterns, env.Name) {
+					continue
+				}
+
+				violations = append(violations, Violation{
+					RuleID:   r.ID(),
+					RuleName: r.Name(),
+					Category: r.Category(),
+					Severity: r.Severity(),
+					Message: fmt.Sprintf("Deployment '%s' container '%s' sets %s inline instead of from a Secret",
+						deployment.Name, container.Name, env.Name),
+					File: bundle.CSV.FilePath,
+					Line: lineOf(bundle.CSV.Node, "spec", "install", "spec", "deployments", strconv.Itoa(i),
+						"spec", "template", "spec", "containers", strconv.Itoa(j), "env", strconv.Itoa(k), "value"),
+					Description: "Inline values are visible to anyone who can read the bundle or the Deployment. Move the value into a Secret and use valueFrom.secretKeyRef.",
+					Fixable:     r.Fixable(),
+				})
+			}
+		}
+	}
+
+	return violations
+}
+
+// matchesAnyPattern reports whether any of the patterns matches name
+func matchesAnyPattern(patterns []*regexp.Regexp, name string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestInlineSecretEnvRule(t *testing.T) {
+	pod := func(env string) string {
+		return `containers:
+- name: manager
+  image: quay.io/example/operator:v1.0.0
+  env:
+` + indent(env, 2)
+	}
+
+	runRuleTests(t, &rules.InlineSecretEnvRule{}, []ruleTest{
+		{
+			name: "credentials from a Secret",
+			files: map[string]string{"manifests/csv.yaml": csvWithPod("1", pod(`- name: DB_PASSWORD
+  valueFrom:
+    secretKeyRef:
+      name: db-credentials
+      key: password
+- name: LOG_LEVEL
+  value: debug
+- name: API_TOKEN
+  value: ""
+`))},
+		},
+		{
+			name: "inline credentials",
+			files: map[string]string{"manifests/csv.yaml": csvWithPod("1", pod(`- name: db_password
+  value: hunter2
+- name: WATCH_NAMESPACE
+  value: ""
+- name: GITHUB_TOKEN
+  value: ghp_example
+`))},
+			want: []string{
+				"Deployment 'example-operator' container 'manager' sets db_password inline instead of from a Secret",
+				"sets GITHUB_TOKEN inline",
+			},
+		},
+	})
+
+	// Configured patterns replace the defaults
+	runRuleTests(t, &rules.InlineSecretEnvRule{SensitivePatterns: []string{"CREDENTIALS"}}, []ruleTest{
+		{
+			name: "custom patterns",
+			files: map[string]string{"manifests/csv.yaml": csvWithPod("1", pod(`- name: API_TOKEN
+  value: example
+- name: CREDENTIALS
+  value: example
+`))},
+			want: []string{"sets CREDENTIALS inline"},
+		},
+	})
+}
@@ -0,0 +1,138 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ODH-OLM-059: Single-Replica Deployment Guarded by a Blocking PodDisruptionBudget
+
+type SingleReplicaPDBRule struct{}
+
+func (r *SingleReplicaPDBRule) ID() string {
+	return "ODH-OLM-059"
+}
+
+func (r *SingleReplicaPDBRule) Name() string {
+	return "single-replica-blocking-pdb"
+}
+
+func (r *SingleReplicaPDBRule) Category() Category {
+	return CategoryUpgrade
+}
+
+func (r *SingleReplicaPDBRule) Severity() Severity {
+	return SeverityWarning
+}
+
+func (r *SingleReplicaPDBRule) Description() string {
+	return "A PodDisruptionBudget that selects the pods of a single-replica install deployment and requires a pod to stay available (minAvailable of 1 or more, or maxUnavailable: 0) can never allow an eviction. Node drains stall on the operator pod, which blocks cluster upgrades and maintenance."
+}
+
+func (r *SingleReplicaPDBRule) Fixable() bool {
+	return false
+}
+
+func (r *SingleReplicaPDBRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Run at least two replicas (with leader election) so one can be evicted at a time, or drop the PodDisruptionBudget, or set maxUnavailable: 1 on it.",
+		BadExample: `# CSV deployment
+spec:
+  replicas: 1
+  template:
+    metadata:
+      labels:
+        app: my-operator
+# PodDisruptionBudget
+spec:
+  minAvailable: 1
+  selector:
+    matchLabels:
+      app: my-operator`,
+		GoodExample: `# CSV deployment
+spec:
+  replicas: 2
+  template:
+    metadata:
+      labels:
+        app: my-operator
+# PodDisruptionBudget
+spec:
+  minAvailable: 1
+  selector:
+    matchLabels:
+      app: my-operator`,
+		DocsURL: "https://kubernetes.io/docs/tasks/run-application/configure-pdb/#think-about-how-your-application-reacts-to-disruptions",
+	}
+}
+
+// pdbBlocksSingleReplica reports which field of a PodDisruptionBudget
+// keeps a lone pod from ever being evicted, or "" if it allows eviction
+func pdbBlocksSingleReplica(pdb *Resource) string {
+	if minAvail, ok := pdb.Spec["minAvailable"]; ok {
+		if !isZeroValue(minAvail) {
+			return "minAvailable"
+		}
+		return ""
+	}
+	if maxUnavail, ok := pdb.Spec["maxUnavailable"]; ok && isZeroValue(maxUnavail) {
+		return "maxUnavailable"
+	}
+	return ""
+}
+
+func (r *SingleReplicaPDBRule) SkipReason(bundle *Bundle) string {
+	if bundle.SingleManifest {
+		return skipSingleManifest
+	}
+	if bundle.CSV == nil {
+		return skipNoCSV
+	}
+	if !hasResourceKind(bundle, "PodDisruptionBudget") {
+		return "the bundle has no PodDisruptionBudget"
+	}
+	return ""
+}
+
+func (r *SingleReplicaPDBRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	if bundle.CSV == nil {
+		return violations
+	}
+
+	for i, deployment := range bundle.CSV.Spec.Install.Spec.Deployments {
+		replicas := 1
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+		if replicas != 1 {
+			continue
+		}
+
+		for _, pdb := range bundle.OtherResources {
+			if pdb.Kind != "PodDisruptionBudget" || !selectorMatches(pdb.Spec["selector"], deployment.Spec.Template.Labels) {
+				continue
+			}
+			field := pdbBlocksSingleReplica(pdb)
+			if field == "" {
+				continue
+			}
+
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("Deployment '%s' runs a single replica, but PodDisruptionBudget '%s' (%s: %v) requires it to stay available",
+					deployment.Name, pdb.Metadata.Name, field, pdb.Spec[field]),
+				File:        bundle.CSV.FilePath,
+				Line:        lineOf(bundle.CSV.Node, "spec", "install", "spec", "deployments", strconv.Itoa(i), "spec", "replicas"),
+				Description: "The operator pod can never be evicted, so node drains block. Run two or more replicas, or let the PodDisruptionBudget allow one pod to be unavailable.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
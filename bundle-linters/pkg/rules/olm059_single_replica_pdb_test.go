@@ -0,0 +1,66 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/bundle-linters/pkg/rules"
+)
+
+func TestSingleReplicaPDBRule(t *testing.T) {
+	pod := `containers:
+- name: manager
+  image: quay.io/example/operator:v1.0.0
+`
+	pdb := func(budget, app string) string {
+		return `apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: example-pdb
+spec:
+  ` + budget + `
+  selector:
+    matchLabels:
+      app: ` + app + `
+`
+	}
+
+	runRuleTests(t, &rules.SingleReplicaPDBRule{}, []ruleTest{
+		{
+			name: "two replicas",
+			files: map[string]string{
+				"manifests/csv.yaml": csvWithPod("2", pod),
+				"manifests/pdb.yaml": pdb("minAvailable: 1", "example-operator"),
+			},
+		},
+		{
+			name: "budget allows an eviction",
+			files: map[string]string{
+				"manifests/csv.yaml": csvWithPod("1", pod),
+				"manifests/pdb.yaml": pdb("maxUnavailable: 1", "example-operator"),
+			},
+		},
+		{
+			name: "budget selects other pods",
+			files: map[string]string{
+				"manifests/csv.yaml": csvWithPod("1", pod),
+				"manifests/pdb.yaml": pdb("minAvailable: 1", "example-worker"),
+			},
+		},
+		{
+			name: "minAvailable on a single replica",
+			files: map[string]string{
+				"manifests/csv.yaml": csvWithPod("1", pod),
+				"manifests/pdb.yaml": pdb("minAvailable: 1", "example-operator"),
+			},
+			want: []string{"Deployment 'example-operator' runs a single replica, but PodDisruptionBudget 'example-pdb' (minAvailable: 1) requires it to stay available"},
+		},
+		{
+			name: "maxUnavailable 0 on a single replica",
+			files: map[string]string{
+				"manifests/csv.yaml": csvWithPod("1", pod),
+				"manifests/pdb.yaml": pdb("maxUnavailable: 0", "example-operator"),
+			},
+			want: []string{"PodDisruptionBudget 'example-pdb' (maxUnavailable: 0)"},
+		},
+	})
+}
@@ -0,0 +1,312 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ODH-OLM-060: Unrecognized or Removed apiVersion/kind
+
+// apiVersionStatus describes one apiVersion a kind is known at
+type apiVersionStatus struct {
+	RemovedIn  string // Kubernetes release that stopped serving it, "" while served
+	ReplacedBy string // apiVersion to migrate to once removed
+}
+
+// apiServed is the status of an apiVersion the API server still serves
+var apiServed = apiVersionStatus{}
+
+// knownKinds lists the kinds a bundle is expected to ship and the
+// apiVersions each is known at. Add new kinds or apiVersions here; kinds
+// defined by the bundle's own CRDs are recognized without an entry.
+var knownKinds = map[string]map[string]apiVersionStatus{
+	// Operator Lifecycle Manager
+	"ClusterServiceVersion": {"operators.coreos.com/v1alpha1": apiServed},
+
+	// Kubernetes core
+	"ConfigMap":             {"v1": apiServed},
+	"Secret":                {"v1": apiServed},
+	"Service":               {"v1": apiServed},
+	"ServiceAccount":        {"v1": apiServed},
+	"PersistentVolumeClaim": {"v1": apiServed},
+
+	// Workloads
+	"Deployment": {
+		"apps/v1":            apiServed,
+		"apps/v1beta1":       {RemovedIn: "1.16", ReplacedBy: "apps/v1"},
+		"apps/v1beta2":       {RemovedIn: "1.16", ReplacedBy: "apps/v1"},
+		"extensions/v1beta1": {RemovedIn: "1.16", ReplacedBy: "apps/v1"},
+	},
+	"DaemonSet": {
+		"apps/v1":            apiServed,
+		"apps/v1beta2":       {RemovedIn: "1.16", ReplacedBy: "apps/v1"},
+		"extensions/v1beta1": {RemovedIn: "1.16", ReplacedBy: "apps/v1"},
+	},
+	"StatefulSet": {
+		"apps/v1":      apiServed,
+		"apps/v1beta1": {RemovedIn: "1.16", ReplacedBy: "apps/v1"},
+		"apps/v1beta2": {RemovedIn: "1.16", ReplacedBy: "apps/v1"},
+	},
+	"Job": {"batch/v1": apiServed},
+	"CronJob": {
+		"batch/v1":      apiServed,
+		"batch/v1beta1": {RemovedIn: "1.25", ReplacedBy: "batch/v1"},
+	},
+	"HorizontalPodAutoscaler": {
+		"autoscaling/v1":      apiServed,
+		"autoscaling/v2":      apiServed,
+		"autoscaling/v2beta1": {RemovedIn: "1.25", ReplacedBy: "autoscaling/v2"},
+		"autoscaling/v2beta2": {RemovedIn: "1.26", ReplacedBy: "autoscaling/v2"},
+	},
+	"VerticalPodAutoscaler": {"autoscaling.k8s.io/v1": apiServed},
+	"PodDisruptionBudget": {
+		"policy/v1":      apiServed,
+		"policy/v1beta1": {RemovedIn: "1.25", ReplacedBy: "policy/v1"},
+	},
+	"PriorityClass": {
+		"scheduling.k8s.io/v1":       apiServed,
+		"scheduling.k8s.io/v1beta1":  {RemovedIn: "1.17", ReplacedBy: "scheduling.k8s.io/v1"},
+		"scheduling.k8s.io/v1alpha1": {RemovedIn: "1.17", ReplacedBy: "scheduling.k8s.io/v1"},
+	},
+
+	// RBAC
+	"Role":               rbacVersions,
+	"ClusterRole":        rbacVersions,
+	"RoleBinding":        rbacVersions,
+	"ClusterRoleBinding": rbacVersions,
+
+	// Networking
+	"NetworkPolicy": {
+		"networking.k8s.io/v1": apiServed,
+		"extensions/v1beta1":   {RemovedIn: "1.16", ReplacedBy: "networking.k8s.io/v1"},
+	},
+	"Ingress": {
+		"networking.k8s.io/v1":      apiServed,
+		"networking.k8s.io/v1beta1": {RemovedIn: "1.22", ReplacedBy: "networking.k8s.io/v1"},
+		"extensions/v1beta1":        {RemovedIn: "1.22", ReplacedBy: "networking.k8s.io/v1"},
+	},
+
+	// API extensions and admission
+	"CustomResourceDefinition": {
+		"apiextensions.k8s.io/v1":      apiServed,
+		"apiextensions.k8s.io/v1beta1": {RemovedIn: "1.22", ReplacedBy: "apiextensions.k8s.io/v1"},
+	},
+	"APIService": {
+		"apiregistration.k8s.io/v1":      apiServed,
+		"apiregistration.k8s.io/v1beta1": {RemovedIn: "1.22", ReplacedBy: "apiregistration.k8s.io/v1"},
+	},
+	"ValidatingWebhookConfiguration": admissionVersions,
+	"MutatingWebhookConfiguration":   admissionVersions,
+
+	// Prometheus Operator
+	"ServiceMonitor": {"monitoring.coreos.com/v1": apiServed},
+	"PodMonitor":     {"monitoring.coreos.com/v1": apiServed},
+	"PrometheusRule": {"monitoring.coreos.com/v1": apiServed},
+
+	// OpenShift
+	"ConsoleYAMLSample":  {"console.openshift.io/v1": apiServed},
+	"ConsoleQuickStart":  {"console.openshift.io/v1": apiServed},
+	"ConsoleCLIDownload": {"console.openshift.io/v1": apiServed},
+	"ConsoleLink":        {"console.openshift.io/v1": apiServed},
+	"ConsolePlugin": {
+		"console.openshift.io/v1":       apiServed,
+		"console.openshift.io/v1alpha1": apiServed,
+	},
+	"Route":                      {"route.openshift.io/v1": apiServed},
+	"SecurityContextConstraints": {"security.openshift.io/v1": apiServed},
+}
+
+var rbacVersions = map[string]apiVersionStatus{
+	"rbac.authorization.k8s.io/v1":       apiServed,
+	"rbac.authorization.k8s.io/v1beta1":  {RemovedIn: "1.22", ReplacedBy: "rbac.authorization.k8s.io/v1"},
+	"rbac.authorization.k8s.io/v1alpha1": {RemovedIn: "1.22", ReplacedBy: "rbac.authorization.k8s.io/v1"},
+}
+
+var admissionVersions = map[string]apiVersionStatus{
+	"admissionregistration.k8s.io/v1":      apiServed,
+	"admissionregistration.k8s.io/v1beta1": {RemovedIn: "1.22", ReplacedBy: "admissionregistration.k8s.io/v1"},
+}
+
+type KnownAPIKindRule struct {
+	// AllowedKinds are further kinds accepted at any apiVersion, e.g. the
+	// custom resources of an operator the bundle depends on
+	AllowedKinds []string `yaml:"allowedKinds"`
+}
+
+func (r *KnownAPIKindRule) ID() string {
+	return "ODH-OLM-060"
+}
+
+func (r *KnownAPIKindRule) Name() string {
+	return "unknown-or-removed-api"
+}
+
+func (r *KnownAPIKindRule) Category() Category {
+	return CategoryUpgrade
+}
+
+func (r *KnownAPIKindRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *KnownAPIKindRule) Description() string {
+	return "Every manifest's apiVersion and kind are checked against a table of known types. An apiVersion that Kubernetes no longer serves, such as extensions/v1beta1 for a Deployment or policy/v1beta1 for a PodDisruptionBudget, is an error: the install fails on current clusters. A kind or apiVersion missing from the table, often a typo such as Deploymnet, is reported as a warning, since the manifest is loaded as a generic resource and the checks for its kind skip it. Kinds defined by the bundle's CRDs or the CSV's owned and required CRDs are accepted, and more can be allowed with the allowedKinds setting."
+}
+
+func (r *KnownAPIKindRule) Fixable() bool {
+	return false
+}
+
+func (r *KnownAPIKindRule) Configure(settings map[string]interface{}) error {
+	return decodeSettings(settings, r)
+}
+
+func (r *KnownAPIKindRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Move removed apiVersions to the version that replaced them, adjusting fields that changed (for example, apps/v1 Deployments require spec.selector). Correct misspelled kinds. Add kinds the table does not know, such as another operator's custom resources, to the allowedKinds setting.",
+		BadExample: `apiVersion: policy/v1beta1
+kind: PodDisruptionBudget
+---
+apiVersion: apps/v1
+kind: Deploymnet`,
+		GoodExample: `apiVersion: policy/v1
+kind: PodDisruptionBudget
+---
+apiVersion: apps/v1
+kind: Deployment`,
+		DocsURL: "https://kubernetes.io/docs/reference/using-api/deprecation-guide/",
+	}
+}
+
+// bundleDefinedKinds returns the "apiVersion kind" pairs of the custom
+// resources the bundle defines or depends on
+func bundleDefinedKinds(bundle *Bundle) map[string]bool {
+	defined := make(map[string]bool)
+	for _, crd := range bundle.CRDs {
+		for _, version := range crd.Spec.Versions {
+			defined[crd.Spec.Group+"/"+version.Name+" "+crd.Spec.Names.Kind] = true
+		}
+	}
+	if bundle.CSV != nil {
+		crds := bundle.CSV.Spec.CustomResourceDefinitions
+		for _, refs := range [][]CRDReference{crds.Owned, crds.Required} {
+			for _, ref := range refs {
+				// CRD names are <plural>.<group>
+				if _, group, ok := strings.Cut(ref.Name, "."); ok {
+					defined[group+"/"+ref.Version+" "+ref.Kind] = true
+				}
+			}
+		}
+	}
+	return defined
+}
+
+// closestKnownKind returns the known kind a misspelled kind was probably
+// meant to be, or ""
+func closestKnownKind(kind string) string {
+	best, bestDistance := "", maxKindTypoDistance+1
+	for candidate := range knownKinds {
+		distance := editDistance(strings.ToLower(kind), strings.ToLower(candidate))
+		if distance < bestDistance || (distance == bestDistance && candidate < best) {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+// knownVersions returns the apiVersions still served for a kind, sorted
+func knownVersions(versions map[string]apiVersionStatus) []string {
+	var names []string
+	for name, status := range versions {
+		if status.RemovedIn == "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *KnownAPIKindRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	allowed := make(map[string]bool)
+	for _, kind := range r.AllowedKinds {
+		allowed[kind] = true
+	}
+	defined := bundleDefinedKinds(bundle)
+
+	check := func(filePath, apiVersion, kind string, node *yaml.Node) {
+		if kind == "" || allowed[kind] || defined[apiVersion+" "+kind] {
+			return
+		}
+
+		versions, known := knownKinds[kind]
+		if !known {
+			message := fmt.Sprintf("Kind '%s' (apiVersion %s) is not a recognized type", kind, apiVersion)
+			if suggestion := closestKnownKind(kind); suggestion != "" {
+				message += fmt.Sprintf("; did you mean %s?", suggestion)
+			}
+			violations = append(violations, Violation{
+				RuleID:      r.ID(),
+				RuleName:    r.Name(),
+				Category:    r.Category(),
+				Severity:    SeverityWarning,
+				Message:     message,
+				File:        filePath,
+				Line:        lineOf(node, "kind"),
+				Description: "The manifest is loaded as a generic resource, so the checks for its kind skip it. Correct the kind, or add it to the allowedKinds setting if it is intended.",
+				Fixable:     r.Fixable(),
+			})
+			return
+		}
+
+		status, known := versions[apiVersion]
+		switch {
+		case !known:
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: SeverityWarning,
+				Message: fmt.Sprintf("%s uses apiVersion '%s', which is not a recognized version of the kind (known: %s)",
+					kind, apiVersion, strings.Join(knownVersions(versions), ", ")),
+				File:        filePath,
+				Line:        lineOf(node, "apiVersion"),
+				Description: "The API server rejects a kind at an apiVersion it does not serve. Check the group and version for typos.",
+				Fixable:     r.Fixable(),
+			})
+		case status.RemovedIn != "":
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("%s uses apiVersion '%s', which was removed in Kubernetes %s; use %s",
+					kind, apiVersion, status.RemovedIn, status.ReplacedBy),
+				File:        filePath,
+				Line:        lineOf(node, "apiVersion"),
+				Description: "Clusters from that release on no longer serve this apiVersion, so installing the bundle fails. Migrate the manifest to the replacement version.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	if bundle.CSV != nil {
+		check(bundle.CSV.FilePath, bundle.CSV.APIVersion, bundle.CSV.Kind, bundle.CSV.Node)
+	}
+	for _, crd := range bundle.CRDs {
+		check(crd.FilePath, crd.APIVersion, crd.Kind, crd.Node)
+	}
+	for _, resource := range bundle.OtherResources {
+		// Near misses for the dispatched kinds are reported by ODH-OLM-028
+		if intendedKind(resource) != "" {
+			continue
+		}
+		check(resource.FilePath, resource.APIVersion, resource.Kind, resource.Node)
+	}
+
+	return violations
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKnownAPIKindRule(t *testing.T) {
+	resource := func(apiVersion, kind string) *Resource {
+		return &Resource{FilePath: "manifests/resource.yaml", APIVersion: apiVersion, Kind: kind, Metadata: Metadata{Name: "example"}}
+	}
+	widgetCRD := &CustomResourceDefinition{
+		FilePath:   "manifests/widgets.crd.yaml",
+		APIVersion: "apiextensions.k8s.io/v1",
+		Kind:       "CustomResourceDefinition",
+		Metadata:   Metadata{Name: "widgets.example.com"},
+		Spec: CRDSpec{
+			Group:    "example.com",
+			Names:    CRDNames{Kind: "Widget", Plural: "widgets"},
+			Versions: []CRDVersion{{Name: "v1", Served: true, Storage: true}},
+		},
+	}
+	csv := &ClusterServiceVersion{
+		FilePath:   "manifests/example.clusterserviceversion.yaml",
+		APIVersion: "operators.coreos.com/v1alpha1",
+		Kind:       "ClusterServiceVersion",
+		Spec: CSVSpec{CustomResourceDefinitions: CSVCustomResourceDefinitions{
+			Required: []CRDReference{{Name: "gadgets.dependency.io", Version: "v1beta1", Kind: "Gadget"}},
+		}},
+	}
+
+	tests := []struct {
+		name         string
+		bundle       *Bundle
+		allowedKinds []string
+		want         []string // Messages, in order
+		severities   []Severity
+	}{
+		{
+			name: "served apiVersions",
+			bundle: &Bundle{CSV: csv, CRDs: []*CustomResourceDefinition{widgetCRD}, OtherResources: []*Resource{
+				resource("apps/v1", "Deployment"),
+				resource("policy/v1", "PodDisruptionBudget"),
+				resource("rbac.authorization.k8s.io/v1", "ClusterRole"),
+				resource("monitoring.coreos.com/v1", "ServiceMonitor"),
+			}},
+		},
+		{
+			name: "kinds defined by the bundle's CRDs and the CSV",
+			bundle: &Bundle{CSV: csv, CRDs: []*CustomResourceDefinition{widgetCRD}, OtherResources: []*Resource{
+				resource("example.com/v1", "Widget"),
+				resource("dependency.io/v1beta1", "Gadget"),
+			}},
+		},
+		{
+			name:         "allowed kinds",
+			bundle:       &Bundle{OtherResources: []*Resource{resource("other.io/v1", "Thing")}},
+			allowedKinds: []string{"Thing"},
+		},
+		{
+			name: "removed apiVersions",
+			bundle: &Bundle{OtherResources: []*Resource{
+				resource("policy/v1beta1", "PodDisruptionBudget"),
+				resource("extensions/v1beta1", "Deployment"),
+			}},
+			want: []string{
+				"PodDisruptionBudget uses apiVersion 'policy/v1beta1', which was removed in Kubernetes 1.25; use policy/v1",
+				"Deployment uses apiVersion 'extensions/v1beta1', which was removed in Kubernetes 1.16; use apps/v1",
+			},
+			severities: []Severity{SeverityError, SeverityError},
+		},
+		{
+			name: "removed CRD apiVersion",
+			bundle: &Bundle{CRDs: []*CustomResourceDefinition{{
+				FilePath:   "manifests/widgets.crd.yaml",
+				APIVersion: "apiextensions.k8s.io/v1beta1",
+				Kind:       "CustomResourceDefinition",
+			}}},
+			want:       []string{"CustomResourceDefinition uses apiVersion 'apiextensions.k8s.io/v1beta1', which was removed in Kubernetes 1.22; use apiextensions.k8s.io/v1"},
+			severities: []Severity{SeverityError},
+		},
+		{
+			name:       "misspelled kind",
+			bundle:     &Bundle{OtherResources: []*Resource{resource("apps/v1", "Deploymnet")}},
+			want:       []string{"Kind 'Deploymnet' (apiVersion apps/v1) is not a recognized type; did you mean Deployment?"},
+			severities: []Severity{SeverityWarning},
+		},
+		{
+			name:       "unknown apiVersion",
+			bundle:     &Bundle{OtherResources: []*Resource{resource("rbac.authorization.k8s.io/v2", "Role")}},
+			want:       []string{"Role uses apiVersion 'rbac.authorization.k8s.io/v2', which is not a recognized version of the kind (known: rbac.authorization.k8s.io/v1)"},
+			severities: []Severity{SeverityWarning},
+		},
+		{
+			// Reported by ODH-OLM-028 instead
+			name:   "near miss of a dispatched kind",
+			bundle: &Bundle{OtherResources: []*Resource{resource("operators.coreos.com/v1alpha1", "ClusterServiceVersoin")}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &KnownAPIKindRule{AllowedKinds: tt.allowedKinds}
+			violations := rule.Validate(tt.bundle)
+			if len(violations) != len(tt.want) {
+				t.Fatalf("got %d violation(s), want %d: %v", len(violations), len(tt.want), violations)
+			}
+			for i, v := range violations {
+				if v.Message != tt.want[i] {
+					t.Errorf("message = %q, want %q", v.Message, tt.want[i])
+				}
+				if v.Severity != tt.severities[i] {
+					t.Errorf("%q: severity = %s, want %s", v.Message, v.Severity, tt.severities[i])
+				}
+				if !strings.HasPrefix(v.File, "manifests/") {
+					t.Errorf("%q reported without its file", v.Message)
+				}
+			}
+		})
+	}
+}
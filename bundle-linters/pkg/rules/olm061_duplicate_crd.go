@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// ODH-OLM-061: CRD Defined More Than Once
+
+type DuplicateCRDRule struct{}
+
+func (r *DuplicateCRDRule) ID() string {
+	return "ODH-OLM-061"
+}
+
+func (r *DuplicateCRDRule) Name() string {
+	return "duplicate-crd"
+}
+
+func (r *DuplicateCRDRule) Category() Category {
+	return CategoryOLMRequirement
+}
+
+func (r *DuplicateCRDRule) Severity() Severity {
+	return SeverityError
+}
+
+func (r *DuplicateCRDRule) Description() string {
+	return "Each CRD, identified by its plural name and group (plural.group), must be defined in only one manifest file. OLM applies the bundle's manifests in no guaranteed order, so which definition ends up on the cluster is undefined. A second copy is usually a leftover from a rename or a copy-paste error."
+}
+
+func (r *DuplicateCRDRule) Fixable() bool {
+	return false
+}
+
+func (r *DuplicateCRDRule) Explain() Explanation {
+	return Explanation{
+		Remediation: "Keep one manifest per CRD and delete the other copies. If the files differ, merge the intended changes into the one that remains, or fix the names if they were meant to define different CRDs.",
+		BadExample: `# manifests/widgets.crd.yaml
+spec:
+  group: example.com
+  names:
+    plural: widgets
+# manifests/widgets-copy.crd.yaml
+spec:
+  group: example.com
+  names:
+    plural: widgets`,
+		GoodExample: `# manifests/widgets.crd.yaml
+spec:
+  group: example.com
+  names:
+    plural: widgets`,
+		DocsURL: "https://olm.operatorframework.io/docs/tasks/creating-operator-manifests/",
+	}
+}
+
+// crdIdentity returns the plural.group name a CRD is registered under,
+// falling back to metadata.name when the spec leaves either part out
+func crdIdentity(crd *CustomResourceDefinition) string {
+	if crd.Spec.Names.Plural == "" || crd.Spec.Group == "" {
+		return crd.Metadata.Name
+	}
+	return crd.Spec.Names.Plural + "." + crd.Spec.Group
+}
+
+func (r *DuplicateCRDRule) SkipReason(bundle *Bundle) string {
+	if len(bundle.CRDs) == 0 {
+		return skipNoCRDs
+	}
+	return ""
+}
+
+func (r *DuplicateCRDRule) Validate(bundle *Bundle) []Violation {
+	var violations []Violation
+
+	definitions := make(map[string][]*CustomResourceDefinition)
+	var names []string
+	for _, crd := range bundle.CRDs {
+		name := crdIdentity(crd)
+		if name == "" {
+			continue
+		}
+		if _, seen := definitions[name]; !seen {
+			names = append(names, name)
+		}
+		definitions[name] = append(definitions[name], crd)
+	}
+
+	for _, name := range names {
+		crds := definitions[name]
+		if len(crds) < 2 {
+			continue
+		}
+
+		files := make([]string, len(crds))
+		for i, crd := range crds {
+			files[i] = filepath.Base(crd.FilePath)
+		}
+
+		// Report every copy after the first, which is taken as the original
+		for _, crd := range crds[1:] {
+			violations = append(violations, Violation{
+				RuleID:   r.ID(),
+				RuleName: r.Name(),
+				Category: r.Category(),
+				Severity: r.Severity(),
+				Message: fmt.Sprintf("CRD '%s' is defined in %d manifest files: %s",
+					name, len(crds), strings.Join(files, ", ")),
+				File:        crd.FilePath,
+				Line:        lineOf(crd.Node, "spec", "names", "plural"),
+				Description: "Which definition OLM applies last is undefined. Keep a single manifest for the CRD and remove the other copies.",
+				Fixable:     r.Fixable(),
+			})
+		}
+	}
+
+	return violations
+}
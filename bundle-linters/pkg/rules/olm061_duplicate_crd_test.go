@@ -0,0 +1,88 @@
+package rules
+
+import "testing"
+
+func TestDuplicateCRDRule(t *testing.T) {
+	crd := func(file, name, plural, group string) *CustomResourceDefinition {
+		return &CustomResourceDefinition{
+			FilePath:   "bundle/manifests/" + file,
+			APIVersion: "apiextensions.k8s.io/v1",
+			Kind:       "CustomResourceDefinition",
+			Metadata:   Metadata{Name: name},
+			Spec:       CRDSpec{Group: group, Names: CRDNames{Plural: plural}},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		crds  []*CustomResourceDefinition
+		want  []string // Messages, in order
+		files []string // Files reported, in order
+	}{
+		{
+			name: "distinct CRDs",
+			crds: []*CustomResourceDefinition{
+				crd("widgets.crd.yaml", "widgets.example.com", "widgets", "example.com"),
+				crd("gadgets.crd.yaml", "gadgets.example.com", "gadgets", "example.com"),
+				crd("widgets.other.crd.yaml", "widgets.other.io", "widgets", "other.io"),
+			},
+		},
+		{
+			name: "copied CRD",
+			crds: []*CustomResourceDefinition{
+				crd("widgets.crd.yaml", "widgets.example.com", "widgets", "example.com"),
+				crd("widgets-copy.crd.yaml", "widgets.example.com", "widgets", "example.com"),
+			},
+			want:  []string{"CRD 'widgets.example.com' is defined in 2 manifest files: widgets.crd.yaml, widgets-copy.crd.yaml"},
+			files: []string{"bundle/manifests/widgets-copy.crd.yaml"},
+		},
+		{
+			// The spec decides what the API server registers, not the name
+			name: "same spec under another metadata.name",
+			crds: []*CustomResourceDefinition{
+				crd("widgets.crd.yaml", "widgets.example.com", "widgets", "example.com"),
+				crd("old-widgets.crd.yaml", "old-widgets.example.com", "widgets", "example.com"),
+			},
+			want:  []string{"CRD 'widgets.example.com' is defined in 2 manifest files: widgets.crd.yaml, old-widgets.crd.yaml"},
+			files: []string{"bundle/manifests/old-widgets.crd.yaml"},
+		},
+		{
+			name: "three copies, identified by metadata.name",
+			crds: []*CustomResourceDefinition{
+				crd("a.yaml", "widgets.example.com", "", ""),
+				crd("b.yaml", "widgets.example.com", "", ""),
+				crd("c.yaml", "widgets.example.com", "", ""),
+			},
+			want: []string{
+				"CRD 'widgets.example.com' is defined in 3 manifest files: a.yaml, b.yaml, c.yaml",
+				"CRD 'widgets.example.com' is defined in 3 manifest files: a.yaml, b.yaml, c.yaml",
+			},
+			files: []string{"bundle/manifests/b.yaml", "bundle/manifests/c.yaml"},
+		},
+		{
+			name: "CRDs without a name",
+			crds: []*CustomResourceDefinition{
+				crd("a.yaml", "", "", ""),
+				crd("b.yaml", "", "", ""),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &DuplicateCRDRule{}
+			violations := rule.Validate(&Bundle{CRDs: tt.crds})
+			if len(violations) != len(tt.want) {
+				t.Fatalf("got %d violation(s), want %d: %v", len(violations), len(tt.want), violations)
+			}
+			for i, v := range violations {
+				if v.Message != tt.want[i] || v.File != tt.files[i] {
+					t.Errorf("violation %d = %q in %s, want %q in %s", i, v.Message, v.File, tt.want[i], tt.files[i])
+				}
+				if v.Severity != rule.Severity() {
+					t.Errorf("severity = %s, want %s", v.Severity, rule.Severity())
+				}
+			}
+		})
+	}
+}
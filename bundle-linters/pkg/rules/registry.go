@@ -1,5 +1,11 @@
 package rules
 
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
 // GetAllRules returns all available validation rules
 func GetAllRules() []Rule {
 	return []Rule{
@@ -11,6 +17,57 @@ func GetAllRules() []Rule {
 		&PriorityClassGlobalDefaultRule{},
 		&ChannelNamingRule{},
 		&ConversionPreserveUnknownFieldsRule{},
+		&WebhookTimeoutRule{},
+		&ImagePullSecretsRule{},
+		&ConversionCABundleRule{},
+		&CSVSchemaRule{},
+		&MetricsWiringRule{},
+		&ContainerImageRelatedImagesRule{},
+		&CRDScopeInstallModeRule{},
+		&DeploymentStrategyRule{},
+		&ReplicaCountRule{},
+		&WebhookPathCollisionRule{},
+		&OwnedCRDMetadataRule{},
+		&CRDCategoriesRule{},
+		&RequiredAnnotationsRule{},
+		&MinKubeVersionFloorRule{},
+		&MixedRegistriesRule{},
+		&SkipRangeReplacesRule{},
+		&EmptyContainerImageRule{},
+		&MisspelledKindRule{},
+		&WebhookDefinitionKeysRule{},
+		&MultiVersionConversionRule{},
+		&GeneratedNameLengthRule{},
+		&HostPathVolumeRule{},
+		&ContainerResourcesRule{},
+		&UnpinnedImageTagRule{},
+		&ConversionWebhookPDBRule{},
+		&ImageDigestPinningRule{},
+		&PackageNameMismatchRule{},
+		&YAMLLintRule{},
+		&PrivilegedContainerRule{},
+		&OwnedCRDManifestRule{},
+		&CRDStorageVersionRule{},
+		&AdmissionReviewVersionsRule{},
+		&RequiredCRDReferenceRule{},
+		&RBACWildcardRule{},
+		&CSVNameSemverRule{},
+		&HardcodedNamespaceRule{},
+		&CSVAnnotationsRule{},
+		&ConversionServiceRule{},
+		&HostNamespacesRule{},
+		&PullPolicyAlwaysRule{},
+		&InstallModesRule{},
+		&CRDNamingRule{},
+		&DefaultChannelRule{},
+		&EmptyDirSizeLimitRule{},
+		&HealthProbesRule{},
+		&V1PreserveUnknownFieldsRule{},
+		&WebhookFailurePolicyRule{},
+		&InlineSecretEnvRule{},
+		&SingleReplicaPDBRule{},
+		&KnownAPIKindRule{},
+		&DuplicateCRDRule{},
 	}
 }
 
@@ -26,13 +83,107 @@ func GetRuleByID(id string) Rule {
 
 // ValidateBundle runs all rules against a bundle and returns violations
 func ValidateBundle(bundle *Bundle, rules []Rule) []Violation {
-	var allViolations []Violation
+	return ValidateBundleWithOptions(bundle, rules, ValidateOptions{}).Violations
+}
+
+// ValidateOptions controls how rules are run against a bundle
+type ValidateOptions struct {
+	// FailFast stops after the first rule that reports an error-severity
+	// violation. Rules run in order, so the result is deterministic.
+	FailFast bool
+
+	// Adjust, when set, is applied to each rule's violations as they are
+	// collected, before FailFast inspects them (e.g. severity overrides)
+	Adjust func(violations []Violation)
+
+	// OnRuleEvaluated, when set, is called after each rule has run with the
+	// violations it reported (after Adjust)
+	OnRuleEvaluated func(rule Rule, violations []Violation)
+
+	// Logger, when set, receives a debug record for each rule with the
+	// number of violations and how long it took, or why it was skipped
+	Logger *slog.Logger
+}
+
+// ValidationResult holds the outcome of running rules against a bundle
+type ValidationResult struct {
+	Violations []Violation
+	StoppedBy  string // ID of the rule that triggered FailFast, empty for a full scan
+	Skipped    int    // Rules not run because of FailFast
+
+	// NotApplicable lists the rules that were evaluated but do not apply
+	// to the bundle, in the order they ran
+	NotApplicable []SkippedRule
+}
+
+// SkippedRule records why a rule did not apply to a bundle
+type SkippedRule struct {
+	RuleID string `json:"ruleId"`
+	Reason string `json:"reason"`
+}
+
+// ValidateBundleWithOptions runs rules against a bundle using the given options
+func ValidateBundleWithOptions(bundle *Bundle, rules []Rule, opts ValidateOptions) ValidationResult {
+	result, _ := ValidateBundleContext(context.Background(), bundle, rules, opts)
+	return result
+}
 
-	for _, rule := range rules {
+// ValidateBundleContext is like ValidateBundleWithOptions but stops between
+// rules once ctx is done, returning the violations found so far and the
+// context's error
+func ValidateBundleContext(ctx context.Context, bundle *Bundle, rules []Rule, opts ValidateOptions) (ValidationResult, error) {
+	var result ValidationResult
+
+	for i, rule := range rules {
+		if err := ctx.Err(); err != nil {
+			result.Skipped = len(rules) - i
+			return result, err
+		}
+
+		if skipper, ok := rule.(Skipper); ok {
+			if reason := skipper.SkipReason(bundle); reason != "" {
+				result.NotApplicable = append(result.NotApplicable, SkippedRule{RuleID: rule.ID(), Reason: reason})
+				if opts.Logger != nil {
+					opts.Logger.Debug("rule not applicable", "rule", rule.ID(), "reason", reason)
+				}
+				if opts.OnRuleEvaluated != nil {
+					opts.OnRuleEvaluated(rule, nil)
+				}
+				continue
+			}
+		}
+
+		start := time.Now()
 		violations := rule.Validate(bundle)
-		allViolations = append(allViolations, violations...)
+		if opts.Logger != nil {
+			opts.Logger.Debug("rule evaluated", "rule", rule.ID(), "violations", len(violations), "duration", time.Since(start))
+		}
+		if opts.Adjust != nil {
+			opts.Adjust(violations)
+		}
+		result.Violations = append(result.Violations, violations...)
+		if opts.OnRuleEvaluated != nil {
+			opts.OnRuleEvaluated(rule, violations)
+		}
+
+		if opts.FailFast && containsError(violations) {
+			result.StoppedBy = rule.ID()
+			result.Skipped = len(rules) - i - 1
+			if opts.Logger != nil {
+				opts.Logger.Debug("stopping at first error", "rule", rule.ID(), "skipped", result.Skipped)
+			}
+			break
+		}
 	}
 
-	return allViolations
+	return result, nil
 }
 
+func containsError(violations []Violation) bool {
+	for _, v := range violations {
+		if v.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
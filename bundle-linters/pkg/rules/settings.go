@@ -0,0 +1,30 @@
+package rules
+
+import (
+	"bytes"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// decodeSettings decodes a rule's settings map into target, which should be
+// a pointer to a struct with yaml tags. Unknown keys are rejected so typos
+// in config files surface as errors instead of being silently ignored.
+func decodeSettings(settings map[string]interface{}, target interface{}) error {
+	if len(settings) == 0 {
+		return nil
+	}
+
+	data, err := yaml.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to encode settings: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("invalid settings: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package rules
+
+// Helpers for rules that implement Skipper, which report why a rule does
+// not apply to a bundle instead of silently finding nothing.
+
+const (
+	skipNoCSV         = "the bundle has no ClusterServiceVersion"
+	skipNoAnnotations = "the bundle has no metadata/annotations.yaml"
+	skipNoCRDs        = "the bundle has no CustomResourceDefinitions"
+	skipNoDeployments = "the CSV defines no install deployments"
+	skipNoWebhooks    = "the CSV defines no webhooks"
+
+	// skipSingleManifest is for rules that look for other files of the
+	// bundle, which a lone manifest file does not have
+	skipSingleManifest = "a single manifest file was loaded, not a whole bundle"
+)
+
+// csvHasWebhookType reports whether the CSV defines a webhook of the given
+// type, or of any type when webhookType is empty
+func csvHasWebhookType(csv *ClusterServiceVersion, webhookType string) bool {
+	for _, webhook := range csv.Spec.WebhookDefinitions {
+		if webhookType == "" || webhook.Type == webhookType {
+			return true
+		}
+	}
+	return false
+}
+
+// hasResourceKind reports whether the bundle contains a manifest of kind
+func hasResourceKind(bundle *Bundle, kind string) bool {
+	for _, resource := range bundle.OtherResources {
+		if resource.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
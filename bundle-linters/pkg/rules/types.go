@@ -1,6 +1,10 @@
 package rules
 
-import "fmt"
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
 
 // Severity levels for rule violations
 type Severity string
@@ -21,17 +25,27 @@ const (
 	CategoryUpgrade        Category = "OLM-Upgrade"
 )
 
+// AllCategories returns every rule category in display order
+func AllCategories() []Category {
+	return []Category{
+		CategoryOLMRequirement,
+		CategoryOLMBestPractice,
+		CategorySecurity,
+		CategoryUpgrade,
+	}
+}
+
 // Violation represents a rule violation found in a bundle
 type Violation struct {
-	RuleID      string   // e.g., "ODH-OLM-001"
-	RuleName    string   // e.g., "missing-minkubeversion"
-	Category    Category
-	Severity    Severity
-	Message     string
-	File        string
-	Line        int // 0 if not applicable
-	Description string
-	Fixable     bool
+	RuleID      string   `json:"ruleId"`   // e.g., "ODH-OLM-001"
+	RuleName    string   `json:"ruleName"` // e.g., "missing-minkubeversion"
+	Category    Category `json:"category"`
+	Severity    Severity `json:"severity"`
+	Message     string   `json:"message"`
+	File        string   `json:"file,omitempty"`
+	Line        int      `json:"line,omitempty"` // 0 if not applicable
+	Description string   `json:"description,omitempty"`
+	Fixable     bool     `json:"fixable"`
 }
 
 // Rule defines a validation rule for operator bundles
@@ -58,6 +72,41 @@ type Rule interface {
 	Fixable() bool
 }
 
+// Explanation holds extended documentation for a rule
+type Explanation struct {
+	Remediation string // How to resolve a violation
+	BadExample  string // YAML snippet that triggers the rule
+	GoodExample string // YAML snippet that satisfies the rule
+	DocsURL     string // Upstream documentation for the requirement
+}
+
+// Explainer is implemented by rules that provide extended documentation
+// beyond Description()
+type Explainer interface {
+	Explain() Explanation
+}
+
+// Configurable is implemented by rules that accept settings from the
+// config file's per-rule "settings" block
+type Configurable interface {
+	Configure(settings map[string]interface{}) error
+}
+
+// Fixer is implemented by fixable rules. Fix is called with the root node
+// of every YAML document in the file a violation was reported in, edits the
+// document in place and reports whether it changed anything. Documents the
+// fix does not apply to must be left untouched.
+type Fixer interface {
+	Fix(doc *yaml.Node, violation Violation) (bool, error)
+}
+
+// Skipper is implemented by rules that only apply to some bundles.
+// SkipReason returns why the rule does not apply to a bundle, or "" when it
+// does. Validate is not called for bundles the rule does not apply to.
+type Skipper interface {
+	SkipReason(bundle *Bundle) string
+}
+
 // Bundle represents an operator bundle structure
 type Bundle struct {
 	Path            string
@@ -67,6 +116,8 @@ type Bundle struct {
 	CRDs            []*CustomResourceDefinition
 	OtherResources  []*Resource
 	Annotations     *BundleAnnotations
+	YAMLProblems    []YAMLProblem // Set when loaded with YAML linting
+	SingleManifest  bool          // Set when a lone manifest file was loaded instead of a bundle directory
 }
 
 // ClusterServiceVersion represents parsed CSV data
@@ -76,15 +127,27 @@ type ClusterServiceVersion struct {
 	Kind               string
 	Metadata           Metadata
 	Spec               CSVSpec
+	Object             map[string]interface{} // Full decoded document
+	Node               *yaml.Node             // Parsed document, for source line numbers
 }
 
 // CSVSpec contains the CSV specification
 type CSVSpec struct {
 	MinKubeVersion     string
+	Version            string   // spec.version
+	Replaces           string   // Name of the CSV this one replaces
+	Skips              []string // Names of CSVs this one skips
 	InstallModes       []InstallMode
 	WebhookDefinitions []WebhookDefinition
 	CustomResourceDefinitions CSVCustomResourceDefinitions
 	Install            CSVInstall
+	RelatedImages      []RelatedImage
+}
+
+// RelatedImage is an image listed in spec.relatedImages for disconnected mirroring
+type RelatedImage struct {
+	Name  string
+	Image string
 }
 
 // CSVCustomResourceDefinitions contains owned and required CRDs
@@ -95,9 +158,11 @@ type CSVCustomResourceDefinitions struct {
 
 // CRDReference references a CRD
 type CRDReference struct {
-	Name    string
-	Version string
-	Kind    string
+	Name        string
+	Version     string
+	Kind        string
+	DisplayName string
+	Description string
 }
 
 // CSVInstall defines the install strategy
@@ -108,7 +173,14 @@ type CSVInstall struct {
 
 // InstallSpec contains deployment information
 type InstallSpec struct {
-	Deployments []Deployment
+	Deployments        []Deployment
+	Permissions        []Permission
+	ClusterPermissions []Permission
+}
+
+// Permission grants RBAC rules to a service account
+type Permission struct {
+	ServiceAccountName string
 }
 
 // Deployment represents a deployment in the CSV
@@ -119,25 +191,101 @@ type Deployment struct {
 
 // DeploymentSpec contains deployment details
 type DeploymentSpec struct {
+	Replicas *int // nil when not specified (defaults to 1)
+	Strategy DeploymentStrategy
 	Template PodTemplateSpec
 }
 
+// DeploymentStrategy describes how a deployment's pods are replaced on update
+type DeploymentStrategy struct {
+	Type          string // RollingUpdate, Recreate, or empty for the default (RollingUpdate)
+	RollingUpdate *RollingUpdateDeployment
+}
+
+// RollingUpdateDeployment holds rolling update parameters. Values are
+// integers or percentages as written in the manifest, empty when unset.
+type RollingUpdateDeployment struct {
+	MaxUnavailable string
+	MaxSurge       string
+}
+
 // PodTemplateSpec contains pod template
 type PodTemplateSpec struct {
-	Spec PodSpec
+	Labels map[string]string // metadata.labels, matched by selectors
+	Spec   PodSpec
 }
 
 // PodSpec contains pod specification
 type PodSpec struct {
-	Containers []Container
+	Containers         []Container
+	ImagePullSecrets   []string // Names of referenced pull secrets
+	ServiceAccountName string
+	Volumes            []Volume
+	SecurityContext    *SecurityContext // Pod-level defaults for containers
+	HostNetwork        bool
+	HostPID            bool
+	HostIPC            bool
+}
+
+// Volume is a pod volume. Only the sources the rules inspect are parsed;
+// the field for each is set when the volume uses that source.
+type Volume struct {
+	Name      string
+	HostPath  *HostPathVolume
+	ConfigMap string // Name of the referenced ConfigMap
+	Secret    string // Name of the referenced Secret
+	EmptyDir  *EmptyDirVolume
+}
+
+// HostPathVolume mounts a path from the node's filesystem
+type HostPathVolume struct {
+	Path string
+	Type string // e.g. Directory, Socket, or empty for no check
+}
+
+// EmptyDirVolume is scratch space that lives as long as the pod
+type EmptyDirVolume struct {
+	Medium    string // "Memory" for tmpfs, or empty for node storage
+	SizeLimit string // Quantity such as 1Gi, or empty when unbounded
 }
 
 // Container represents a container
 type Container struct {
-	Name    string
-	Image   string
-	Command []string
-	Args    []string
+	Name            string
+	Image           string
+	Command         []string
+	Args            []string
+	Resources       ResourceRequirements
+	SecurityContext *SecurityContext
+	ImagePullPolicy string // Always, IfNotPresent, Never, or empty
+	LivenessProbe   bool   // Whether a livenessProbe is defined
+	ReadinessProbe  bool   // Whether a readinessProbe is defined
+	Env             []EnvVar
+}
+
+// EnvVar is a container environment variable, set either inline with
+// value or from another resource with valueFrom
+type EnvVar struct {
+	Name         string
+	Value        string // Inline value, empty when valueFrom is used
+	SecretKeyRef string // Secret named by valueFrom.secretKeyRef, if any
+}
+
+// SecurityContext holds the privilege settings of a container or pod. Nil
+// fields were not set. Privileged and AllowPrivilegeEscalation only apply
+// to containers.
+type SecurityContext struct {
+	Privileged               *bool
+	AllowPrivilegeEscalation *bool
+	RunAsNonRoot             *bool
+	RunAsUser                *int64
+}
+
+// ResourceRequirements holds a container's compute resources, keyed by
+// resource name (cpu, memory, ...) with quantities as written
+type ResourceRequirements struct {
+	Requests map[string]string
+	Limits   map[string]string
 }
 
 // InstallMode defines how the operator can be installed
@@ -157,6 +305,7 @@ type WebhookDefinition struct {
 	SideEffects             string
 	WebhookPath             string
 	ConversionCRDs          []string
+	TimeoutSeconds          *int // nil when not specified
 }
 
 // WebhookRule defines rules for a webhook
@@ -182,11 +331,13 @@ type CustomResourceDefinition struct {
 	Kind       string
 	Metadata   Metadata
 	Spec       CRDSpec
+	Node       *yaml.Node // Parsed document, for source line numbers
 }
 
 // CRDSpec contains CRD specification
 type CRDSpec struct {
 	Group                 string
+	Scope                 string // Namespaced or Cluster
 	Names                 CRDNames
 	Versions              []CRDVersion
 	PreserveUnknownFields *bool
@@ -195,9 +346,10 @@ type CRDSpec struct {
 
 // CRDNames contains CRD names
 type CRDNames struct {
-	Kind     string
-	Plural   string
-	Singular string
+	Kind       string
+	Plural     string
+	Singular   string
+	Categories []string
 }
 
 // CRDVersion represents a CRD version
@@ -220,7 +372,8 @@ type CRDConversionWebhook struct {
 
 // WebhookClientConfig contains webhook client configuration
 type WebhookClientConfig struct {
-	Service *ServiceReference
+	Service  *ServiceReference
+	CABundle string
 }
 
 // ServiceReference references a service
@@ -237,6 +390,8 @@ type Resource struct {
 	Kind       string
 	Metadata   Metadata
 	Spec       map[string]interface{}
+	Fields     map[string]interface{} // Top-level fields, e.g. a Role's rules or a binding's roleRef
+	Node       *yaml.Node             // Parsed document, for source line numbers
 }
 
 // BundleAnnotations contains bundle metadata annotations
@@ -248,6 +403,15 @@ type BundleAnnotations struct {
 	Package      string
 	Channels     []string
 	DefaultChannel string
+	Node         *yaml.Node // Parsed document, for source line numbers
+}
+
+// YAMLProblem is a YAML hygiene problem found in a manifest file before it
+// was parsed
+type YAMLProblem struct {
+	FilePath string
+	Line     int
+	Message  string
 }
 
 // String returns a formatted string representation of a violation
@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Helpers for editing YAML documents in fixers and for locating fields in
+// them. They operate on the yaml.v3 node tree so comments and key order
+// survive a fix.
+
+// documentRoot returns the top-level node of a document
+func documentRoot(doc *yaml.Node) *yaml.Node {
+	if doc != nil && doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		return doc.Content[0]
+	}
+	return doc
+}
+
+// documentKind returns the kind field of a document's top-level mapping
+func documentKind(doc *yaml.Node) string {
+	if kind := mappingValue(documentRoot(doc), "kind"); kind != nil {
+		return kind.Value
+	}
+	return ""
+}
+
+// mappingValue returns the value node for key in a mapping node, or nil
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// mappingPath follows a sequence of keys through nested mappings
+func mappingPath(node *yaml.Node, keys ...string) *yaml.Node {
+	for _, key := range keys {
+		node = mappingValue(node, key)
+	}
+	return node
+}
+
+// lineOf returns the source line of the field at path in a document, or 0
+// when the document is unknown. Numeric path elements index sequences. If
+// the path does not resolve fully, the line of the deepest field found is
+// returned so the violation still points somewhere close.
+func lineOf(doc *yaml.Node, path ...string) int {
+	node := documentRoot(doc)
+	if node == nil {
+		return 0
+	}
+	line := node.Line
+	for _, key := range path {
+		switch node.Kind {
+		case yaml.MappingNode:
+			var next *yaml.Node
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == key {
+					line = node.Content[i].Line
+					next = node.Content[i+1]
+					break
+				}
+			}
+			if next == nil {
+				return line
+			}
+			node = next
+		case yaml.SequenceNode:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(node.Content) {
+				return line
+			}
+			node = node.Content[index]
+			line = node.Line
+		default:
+			return line
+		}
+	}
+	return line
+}
+
+// setBoolValue rewrites a scalar node to the given boolean, reporting
+// whether the value changed
+func setBoolValue(node *yaml.Node, value bool) bool {
+	text := "false"
+	if value {
+		text = "true"
+	}
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!bool" && node.Value == text {
+		return false
+	}
+	node.Kind = yaml.ScalarNode
+	node.Tag = "!!bool"
+	node.Style = 0
+	node.Value = text
+	node.Content = nil
+	return true
+}
+
+// newBoolNode creates a boolean scalar node
+func newBoolNode(value bool) *yaml.Node {
+	node := &yaml.Node{}
+	setBoolValue(node, value)
+	return node
+}
+
+// newStringNode creates a plain string scalar node
+func newStringNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestLineOf(t *testing.T) {
+	const manifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: example
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        image: quay.io/example/operator:v1.0.0
+      - name: proxy
+        image: quay.io/example/proxy:v0.14.0
+`
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(manifest), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		path []string
+		want int
+	}{
+		{"document", nil, 1},
+		{"field", []string{"metadata", "name"}, 4},
+		{"sequence item", []string{"spec", "template", "spec", "containers", "1"}, 11},
+		{"field in a sequence item", []string{"spec", "template", "spec", "containers", "1", "image"}, 12},
+		// Unresolved paths fall back to the deepest field found
+		{"missing field", []string{"spec", "template", "spec", "containers", "0", "resources"}, 9},
+		{"index out of range", []string{"spec", "template", "spec", "containers", "2"}, 8},
+		{"key into a scalar", []string{"metadata", "name", "value"}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lineOf(&doc, tt.path...); got != tt.want {
+				t.Errorf("lineOf(%v) = %d, want %d", tt.path, got, tt.want)
+			}
+		})
+	}
+
+	if got := lineOf(nil, "metadata"); got != 0 {
+		t.Errorf("lineOf(nil) = %d, want 0", got)
+	}
+}
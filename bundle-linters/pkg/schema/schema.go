@@ -0,0 +1,421 @@
+package schema
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Schema is a parsed JSON Schema document.
+//
+// The validator implements the structural subset of JSON Schema (draft-07
+// and later) that is useful for describing manifest conventions: type,
+// properties, required, additionalProperties, patternProperties, items,
+// enum, const, pattern, length/size/range bounds, allOf/anyOf/oneOf/not and
+// local $ref pointers. Unsupported keywords such as format are ignored.
+type Schema struct {
+	root map[string]interface{}
+}
+
+// ValidationError describes a single schema violation
+type ValidationError struct {
+	Path    string // JSON path of the failing value, e.g. "$.spec.installModes[0].type"
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Load reads a JSON Schema from a JSON or YAML file
+func Load(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema file: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses a JSON Schema from JSON or YAML data
+func Parse(data []byte) (*Schema, error) {
+	var root map[string]interface{}
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+	if root == nil {
+		return nil, fmt.Errorf("schema document is empty")
+	}
+	return &Schema{root: root}, nil
+}
+
+// Validate checks a decoded document against the schema and returns every
+// violation found, ordered by path
+func (s *Schema) Validate(doc interface{}) []ValidationError {
+	v := &validator{root: s.root}
+	v.validate(s.root, doc, "$")
+	sort.SliceStable(v.errors, func(i, j int) bool {
+		return v.errors[i].Path < v.errors[j].Path
+	})
+	return v.errors
+}
+
+type validator struct {
+	root   map[string]interface{}
+	errors []ValidationError
+}
+
+func (v *validator) fail(path, format string, args ...interface{}) {
+	v.errors = append(v.errors, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// validate checks value against a (sub)schema. Boolean schemas are
+// supported: true accepts everything and false rejects everything.
+func (v *validator) validate(schema interface{}, value interface{}, path string) {
+	switch s := schema.(type) {
+	case bool:
+		if !s {
+			v.fail(path, "value is not allowed")
+		}
+		return
+	case map[string]interface{}:
+		v.validateObjectSchema(s, value, path)
+	}
+}
+
+func (v *validator) validateObjectSchema(s map[string]interface{}, value interface{}, path string) {
+	if ref, ok := s["$ref"].(string); ok {
+		target, err := v.resolveRef(ref)
+		if err != nil {
+			v.fail(path, "%v", err)
+			return
+		}
+		v.validate(target, value, path)
+	}
+
+	if t, ok := s["type"]; ok && !matchesType(t, value) {
+		v.fail(path, "expected type %s, got %s", describeType(t), typeName(value))
+		// Further keywords assume the right type
+		return
+	}
+
+	if enum, ok := s["enum"].([]interface{}); ok {
+		found := false
+		for _, candidate := range enum {
+			if equal(candidate, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.fail(path, "value %v is not one of %v", value, enum)
+		}
+	}
+
+	if constant, ok := s["const"]; ok && !equal(constant, value) {
+		v.fail(path, "value %v must equal %v", value, constant)
+	}
+
+	switch val := value.(type) {
+	case string:
+		v.validateString(s, val, path)
+	case map[string]interface{}:
+		v.validateObject(s, val, path)
+	case []interface{}:
+		v.validateArray(s, val, path)
+	default:
+		if n, ok := toFloat(value); ok {
+			v.validateNumber(s, n, path)
+		}
+	}
+
+	v.validateCombinators(s, value, path)
+}
+
+func (v *validator) validateString(s map[string]interface{}, value, path string) {
+	length := len([]rune(value))
+	if min, ok := toInt(s["minLength"]); ok && length < min {
+		v.fail(path, "string is shorter than %d characters", min)
+	}
+	if max, ok := toInt(s["maxLength"]); ok && length > max {
+		v.fail(path, "string is longer than %d characters", max)
+	}
+	if pattern, ok := s["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			v.fail(path, "invalid pattern %q in schema: %v", pattern, err)
+		} else if !re.MatchString(value) {
+			v.fail(path, "string %q does not match pattern %q", value, pattern)
+		}
+	}
+}
+
+func (v *validator) validateNumber(s map[string]interface{}, value float64, path string) {
+	if min, ok := toFloat(s["minimum"]); ok && value < min {
+		v.fail(path, "%v is less than minimum %v", value, min)
+	}
+	if max, ok := toFloat(s["maximum"]); ok && value > max {
+		v.fail(path, "%v is greater than maximum %v", value, max)
+	}
+	if min, ok := toFloat(s["exclusiveMinimum"]); ok && value <= min {
+		v.fail(path, "%v must be greater than %v", value, min)
+	}
+	if max, ok := toFloat(s["exclusiveMaximum"]); ok && value >= max {
+		v.fail(path, "%v must be less than %v", value, max)
+	}
+}
+
+func (v *validator) validateObject(s map[string]interface{}, value map[string]interface{}, path string) {
+	if required, ok := s["required"].([]interface{}); ok {
+		for _, r := range required {
+			name, _ := r.(string)
+			if _, present := value[name]; !present {
+				v.fail(path, "missing required property %q", name)
+			}
+		}
+	}
+
+	if min, ok := toInt(s["minProperties"]); ok && len(value) < min {
+		v.fail(path, "object has fewer than %d properties", min)
+	}
+	if max, ok := toInt(s["maxProperties"]); ok && len(value) > max {
+		v.fail(path, "object has more than %d properties", max)
+	}
+
+	properties, _ := s["properties"].(map[string]interface{})
+	patternProperties, _ := s["patternProperties"].(map[string]interface{})
+	additional, hasAdditional := s["additionalProperties"]
+
+	for _, key := range sortedKeys(value) {
+		childPath := path + "." + key
+		matched := false
+
+		if propSchema, ok := properties[key]; ok {
+			matched = true
+			v.validate(propSchema, value[key], childPath)
+		}
+
+		for pattern, propSchema := range patternProperties {
+			re, err := regexp.Compile(pattern)
+			if err != nil || !re.MatchString(key) {
+				continue
+			}
+			matched = true
+			v.validate(propSchema, value[key], childPath)
+		}
+
+		if !matched && hasAdditional {
+			if allowed, ok := additional.(bool); ok && !allowed {
+				v.fail(childPath, "additional property %q is not allowed", key)
+			} else {
+				v.validate(additional, value[key], childPath)
+			}
+		}
+	}
+}
+
+func (v *validator) validateArray(s map[string]interface{}, value []interface{}, path string) {
+	if min, ok := toInt(s["minItems"]); ok && len(value) < min {
+		v.fail(path, "array has fewer than %d items", min)
+	}
+	if max, ok := toInt(s["maxItems"]); ok && len(value) > max {
+		v.fail(path, "array has more than %d items", max)
+	}
+	if unique, ok := s["uniqueItems"].(bool); ok && unique {
+		for i := range value {
+			for j := i + 1; j < len(value); j++ {
+				if equal(value[i], value[j]) {
+					v.fail(fmt.Sprintf("%s[%d]", path, j), "duplicate of item %d", i)
+				}
+			}
+		}
+	}
+
+	if items, ok := s["items"]; ok {
+		for i, item := range value {
+			v.validate(items, item, fmt.Sprintf("%s[%d]", path, i))
+		}
+	}
+}
+
+func (v *validator) validateCombinators(s map[string]interface{}, value interface{}, path string) {
+	if allOf, ok := s["allOf"].([]interface{}); ok {
+		for _, sub := range allOf {
+			v.validate(sub, value, path)
+		}
+	}
+
+	if anyOf, ok := s["anyOf"].([]interface{}); ok {
+		if v.countMatches(anyOf, value, path) == 0 {
+			v.fail(path, "value does not match any of the allowed schemas")
+		}
+	}
+
+	if oneOf, ok := s["oneOf"].([]interface{}); ok {
+		if n := v.countMatches(oneOf, value, path); n != 1 {
+			v.fail(path, "value must match exactly one schema, matched %d", n)
+		}
+	}
+
+	if not, ok := s["not"]; ok {
+		if v.countMatches([]interface{}{not}, value, path) == 1 {
+			v.fail(path, "value must not match the schema")
+		}
+	}
+}
+
+// countMatches returns how many of the subschemas accept value, without
+// recording their individual errors
+func (v *validator) countMatches(schemas []interface{}, value interface{}, path string) int {
+	matches := 0
+	for _, sub := range schemas {
+		probe := &validator{root: v.root}
+		probe.validate(sub, value, path)
+		if len(probe.errors) == 0 {
+			matches++
+		}
+	}
+	return matches
+}
+
+// resolveRef resolves a local JSON pointer such as "#/definitions/image"
+func (v *validator) resolveRef(ref string) (interface{}, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("unsupported $ref %q: only local references are supported", ref)
+	}
+
+	var current interface{} = v.root
+	pointer := strings.TrimPrefix(ref, "#")
+	if pointer == "" {
+		return current, nil
+	}
+
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot resolve $ref %q", ref)
+		}
+		if current, ok = obj[token]; !ok {
+			return nil, fmt.Errorf("cannot resolve $ref %q", ref)
+		}
+	}
+
+	return current, nil
+}
+
+// matchesType checks a value against a "type" keyword, which may be a
+// single type name or a list of names
+func matchesType(t interface{}, value interface{}) bool {
+	switch typ := t.(type) {
+	case string:
+		return isType(typ, value)
+	case []interface{}:
+		for _, candidate := range typ {
+			if name, ok := candidate.(string); ok && isType(name, value) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func isType(name string, value interface{}) bool {
+	switch name {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	case "number":
+		_, ok := toFloat(value)
+		return ok
+	case "integer":
+		n, ok := toFloat(value)
+		return ok && n == math.Trunc(n)
+	}
+	return false
+}
+
+func describeType(t interface{}) string {
+	if list, ok := t.([]interface{}); ok {
+		names := make([]string, 0, len(list))
+		for _, item := range list {
+			names = append(names, fmt.Sprint(item))
+		}
+		return strings.Join(names, " or ")
+	}
+	return fmt.Sprint(t)
+}
+
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	}
+	if _, ok := toFloat(value); ok {
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// equal compares decoded values, treating all numeric types as equal when
+// their values match
+func equal(a, b interface{}) bool {
+	if na, ok := toFloat(a); ok {
+		nb, ok := toFloat(b)
+		return ok && na == nb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func toInt(value interface{}) (int, bool) {
+	n, ok := toFloat(value)
+	return int(n), ok
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
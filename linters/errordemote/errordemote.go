@@ -1,8 +1,11 @@
 package errordemote
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
+	"go/types"
+	"path/filepath"
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
@@ -29,6 +32,27 @@ Example flagged code:
 		log.Info("couldn't get config", "error", err)  // Error demoted to log
 	}
 
+The fall-through form, where the error check only logs and the code after
+it continues with the zero value, is flagged too:
+
+	value, err := getConfig(ctx, cli)
+	if err != nil {
+		log.Warn("couldn't get config", "error", err)  // Error demoted to log
+	}
+	config.Value = value
+
+Error cases of a tagless switch that only log, and loops that log an
+error and continue with the next item, are flagged too:
+
+	for _, name := range names {
+		cfg, err := loadConfig(name)
+		if err != nil {
+			log.Warn("couldn't load config", "error", err)  // Error demoted to log
+			continue
+		}
+		configs = append(configs, cfg)
+	}
+
 To suppress, add a comment explaining why the error can be safely ignored:
 
 	//nolint:errordemote // ConfigMap may not exist on non-OCP clusters
@@ -55,39 +79,289 @@ var Analyzer = &analysis.Analyzer{
 	Requires: []*analysis.Analyzer{inspect.Analyzer},
 }
 
+// defaultLogMethods are the method names treated as log calls unless
+// -logmethods is set. Print, Printf and Println cover the standard log
+// package and loggers modelled on it; Fatal and Panic are left out since
+// they do not continue.
+const defaultLogMethods = "Info,Debug,Warn,Warning,Trace,V,Print,Printf,Println" // V: klog verbosity
+
+// defaultResilienceKeywords suppress a finding when a comment above it
+// contains one of them, ignoring case
+const defaultResilienceKeywords = "RESILIENCE:,RESILIENT:,non-critical,optional,safe to ignore,safe to continue,safe default,may not exist"
+
+// defaultResiliencePrefixes suppress a finding under -require-prefix when a
+// comment line above it starts with one of them
+const defaultResiliencePrefixes = "RESILIENCE:,RESILIENT:"
+
+// Flag values
+var (
+	logMethods         string
+	blankErr           bool
+	excludeGenerated   bool
+	excludePatterns    string
+	resilienceKeywords string
+	requirePrefix      bool
+)
+
+func init() {
+	Analyzer.Flags.StringVar(&logMethods, "logmethods", defaultLogMethods,
+		"comma-separated method names treated as log calls, e.g. Info,Debug,Logf,RecordWarning")
+	Analyzer.Flags.BoolVar(&blankErr, "blankerr", false,
+		"also flag error results discarded with the blank identifier, e.g. _ = f() or v, _ := f()")
+	Analyzer.Flags.BoolVar(&excludeGenerated, "exclude-generated", true,
+		"skip files marked with a \"// Code generated ... DO NOT EDIT.\" comment")
+	Analyzer.Flags.StringVar(&excludePatterns, "exclude", "",
+		"comma-separated glob patterns of files to skip, matched against the file path and its base name, e.g. zz_generated_*.go")
+	Analyzer.Flags.StringVar(&resilienceKeywords, "resilience-keywords", "",
+		"comma-separated comment keywords that document a deliberate demotion, replacing the default list (default: "+defaultResilienceKeywords+"; with -require-prefix: "+defaultResiliencePrefixes+")")
+	Analyzer.Flags.BoolVar(&requirePrefix, "require-prefix", false,
+		"only accept a resilience keyword at the start of a comment line, matching case, instead of anywhere in the comment")
+}
+
+// parseLogMethods splits a -logmethods value into a set of method names
+func parseLogMethods(value string) map[string]bool {
+	methods := make(map[string]bool)
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			methods[name] = true
+		}
+	}
+	return methods
+}
+
+// parseResilienceKeywords returns the keywords that document a demotion:
+// the -resilience-keywords value, or the default list for the matching mode
+func parseResilienceKeywords() []string {
+	value := resilienceKeywords
+	if value == "" {
+		value = defaultResilienceKeywords
+		if requirePrefix {
+			value = defaultResiliencePrefixes
+		}
+	}
+
+	var keywords []string
+	for _, keyword := range strings.Split(value, ",") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
+
+// excludedFiles returns the names of the package's files that -exclude-generated
+// or -exclude say to skip
+func excludedFiles(pass *analysis.Pass) map[string]bool {
+	var patterns []string
+	for _, pattern := range strings.Split(excludePatterns, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	excluded := make(map[string]bool)
+	for _, file := range pass.Files {
+		name := pass.Fset.File(file.Pos()).Name()
+		if excludeGenerated && ast.IsGenerated(file) {
+			excluded[name] = true
+			continue
+		}
+		for _, pattern := range patterns {
+			if matchesPattern(pattern, name) {
+				excluded[name] = true
+				break
+			}
+		}
+	}
+	return excluded
+}
+
+// matchesPattern reports whether the glob pattern matches the file's path or
+// any trailing part of it, so zz_generated_*.go matches by base name and
+// api/v1/*.go matches wherever the package is checked out
+func matchesPattern(pattern, name string) bool {
+	parts := strings.Split(filepath.ToSlash(name), "/")
+	for i := range parts {
+		if ok, _ := filepath.Match(filepath.ToSlash(pattern), strings.Join(parts[i:], "/")); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func run(pass *analysis.Pass) (interface{}, error) {
 	inspector := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	methods := parseLogMethods(logMethods)
+	excluded := excludedFiles(pass)
 
 	nodeFilter := []ast.Node{
 		(*ast.IfStmt)(nil),
+		(*ast.SwitchStmt)(nil),
+		(*ast.ForStmt)(nil),
+		(*ast.RangeStmt)(nil),
+	}
+	if blankErr {
+		nodeFilter = append(nodeFilter, (*ast.AssignStmt)(nil))
 	}
 
 	inspector.Preorder(nodeFilter, func(n ast.Node) {
-		ifStmt := n.(*ast.IfStmt)
-
-		// Check if this is the error demotion pattern:
-		// if val, err := fn(); err == nil { ... } else { log... }
-		if isErrorDemotionPattern(ifStmt, pass) {
-			// Check for nolint comment
-			if hasNolintComment(pass, ifStmt.Pos()) {
-				return
+		if excluded[pass.Fset.File(n.Pos()).Name()] {
+			return
+		}
+
+		switch stmt := n.(type) {
+		case *ast.IfStmt:
+			// Check if this is the error demotion pattern:
+			// if val, err := fn(); err == nil { ... } else { log... }
+			// or its fall-through form:
+			// if err != nil { log... } (no return, no else)
+			if isErrorDemotionPattern(stmt, pass.TypesInfo, methods) || isFallThroughPattern(stmt, pass.TypesInfo, methods) {
+				report(pass, stmt.Pos(),
+					"error demoted to log statement instead of being returned; add //nolint:errordemote with justification or return the error")
+			}
+
+		case *ast.SwitchStmt:
+			// switch { case err != nil: log... } (no return)
+			for _, clause := range demotingErrorCases(stmt, pass.TypesInfo, methods) {
+				report(pass, clause.Pos(),
+					"error demoted to log statement in switch case instead of being returned; add //nolint:errordemote with justification or return the error")
+			}
+
+		case *ast.ForStmt:
+			// for ... { if err != nil { log...; continue } }
+			for _, ifStmt := range loopContinueDemotions(stmt.Body, pass.TypesInfo, methods) {
+				report(pass, ifStmt.Pos(),
+					"error demoted to log statement and the loop continues instead of returning it; add //nolint:errordemote with justification or return the error")
 			}
 
-			// Check for explicit resilience documentation
-			if hasResilienceDoc(pass, ifStmt.Pos()) {
-				return
+		case *ast.RangeStmt:
+			for _, ifStmt := range loopContinueDemotions(stmt.Body, pass.TypesInfo, methods) {
+				report(pass, ifStmt.Pos(),
+					"error demoted to log statement and the loop continues instead of returning it; add //nolint:errordemote with justification or return the error")
 			}
 
-			pass.Reportf(ifStmt.Pos(),
-				"error demoted to log statement instead of being returned; add //nolint:errordemote with justification or return the error")
+		case *ast.AssignStmt:
+			// With -blankerr: _ = fn() or val, _ := fn()
+			if call := discardedErrorCall(pass, stmt); call != nil {
+				report(pass, stmt.Pos(), fmt.Sprintf(
+					"error returned by %s discarded with blank identifier; add //nolint:errordemote with justification or handle the error",
+					types.ExprString(call.Fun)))
+			}
 		}
 	})
 
 	return nil, nil
 }
 
+// report reports a diagnostic for the statement at pos unless it is
+// suppressed by a nolint comment or resilience documentation
+func report(pass *analysis.Pass, pos token.Pos, message string) {
+	// Check for nolint comment
+	if hasNolintComment(pass, pos) {
+		return
+	}
+
+	// Check for explicit resilience documentation
+	if hasResilienceDoc(pass, pos) {
+		return
+	}
+
+	diagnostic := analysis.Diagnostic{
+		Pos:     pos,
+		Message: message,
+	}
+	if fix := nolintFix(pass, pos); fix != nil {
+		diagnostic.SuggestedFixes = []analysis.SuggestedFix{*fix}
+	}
+	pass.Report(diagnostic)
+}
+
+// errorType is the built-in error interface
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// discardedErrorCall returns the call whose error result an assignment
+// discards with the blank identifier, or nil. Only calls are considered,
+// and the discarded value must be of a type implementing error.
+func discardedErrorCall(pass *analysis.Pass, assign *ast.AssignStmt) *ast.CallExpr {
+	isBlank := func(expr ast.Expr) bool {
+		ident, ok := expr.(*ast.Ident)
+		return ok && ident.Name == "_"
+	}
+	isError := func(t types.Type) bool {
+		return t != nil && types.Implements(t, errorType)
+	}
+
+	// val, _ := fn(): one call with several results
+	if len(assign.Rhs) == 1 && len(assign.Lhs) > 1 {
+		call, ok := ast.Unparen(assign.Rhs[0]).(*ast.CallExpr)
+		if !ok {
+			return nil
+		}
+		results, ok := pass.TypesInfo.TypeOf(call).(*types.Tuple)
+		if !ok || results.Len() != len(assign.Lhs) {
+			return nil
+		}
+		for i, lhs := range assign.Lhs {
+			if isBlank(lhs) && isError(results.At(i).Type()) {
+				return call
+			}
+		}
+		return nil
+	}
+
+	// _ = fn(), or a, _ = x, fn()
+	for i, lhs := range assign.Lhs {
+		if i >= len(assign.Rhs) || !isBlank(lhs) {
+			continue
+		}
+		call, ok := ast.Unparen(assign.Rhs[i]).(*ast.CallExpr)
+		if ok && isError(pass.TypesInfo.TypeOf(call)) {
+			return call
+		}
+	}
+	return nil
+}
+
+// nolintComment is inserted by the suggested fix; the TODO prompts the
+// author to replace it with the actual justification
+const nolintComment = "//nolint:errordemote // TODO: justify"
+
+// nolintFix returns a fix inserting nolintComment on its own line above the
+// statement at pos, indented to match it. Statements that do not start
+// their line, such as the if of an "else if", get no fix.
+func nolintFix(pass *analysis.Pass, pos token.Pos) *analysis.SuggestedFix {
+	file := pass.Fset.File(pos)
+	if file == nil {
+		return nil
+	}
+	lineStart := file.LineStart(file.Line(pos))
+
+	// Copy the statement's indentation from the source, falling back to
+	// gofmt's tabs when the source is unavailable
+	indent := strings.Repeat("\t", int(pos-lineStart))
+	if pass.ReadFile != nil {
+		content, err := pass.ReadFile(file.Name())
+		if err != nil {
+			return nil
+		}
+		indent = string(content[file.Offset(lineStart):file.Offset(pos)])
+	}
+	if strings.TrimSpace(indent) != "" {
+		return nil
+	}
+
+	return &analysis.SuggestedFix{
+		Message: "Add //nolint:errordemote comment",
+		TextEdits: []analysis.TextEdit{{
+			Pos:     lineStart,
+			End:     lineStart,
+			NewText: []byte(indent + nolintComment + "\n"),
+		}},
+	}
+}
+
 // isErrorDemotionPattern checks if this is the error demotion pattern
-func isErrorDemotionPattern(ifStmt *ast.IfStmt, pass *analysis.Pass) bool {
+func isErrorDemotionPattern(ifStmt *ast.IfStmt, info *types.Info, methods map[string]bool) bool {
 	// Must have an assignment in the init section
 	// Pattern: if val, err := fn(); err == nil { ... } else { ... }
 	if ifStmt.Init == nil {
@@ -104,17 +378,17 @@ func isErrorDemotionPattern(ifStmt *ast.IfStmt, pass *analysis.Pass) bool {
 		return false
 	}
 
-	// Last variable should be named "err" or "_err"
+	// Last variable should be an error (or "_")
 	lastVar, ok := assignStmt.Lhs[len(assignStmt.Lhs)-1].(*ast.Ident)
 	if !ok {
 		return false
 	}
-	if !strings.Contains(lastVar.Name, "err") && lastVar.Name != "_" {
+	if lastVar.Name != "_" && !isErrorExpr(info, lastVar) {
 		return false
 	}
 
 	// Condition should be "err == nil" or "err != nil"
-	if !isErrCondition(ifStmt.Cond) {
+	if !isErrCondition(info, ifStmt.Cond) {
 		return false
 	}
 
@@ -124,54 +398,220 @@ func isErrorDemotionPattern(ifStmt *ast.IfStmt, pass *analysis.Pass) bool {
 	}
 
 	// The else branch should contain logging but NOT return an error
-	hasLog := containsLogCall(ifStmt.Else)
-	returnsError := containsErrorReturn(ifStmt.Else)
+	hasLog := containsLogCall(info, ifStmt.Else, methods)
+	returnsError := containsErrorReturn(info, ifStmt.Else)
 
 	// Pattern: logs error but doesn't return it
 	return hasLog && !returnsError
 }
 
+// isFallThroughPattern checks for an error check whose body only logs,
+// after which execution continues with whatever value the failed call
+// left behind:
+//
+//	cfg, err := getConfig(ctx, cli)
+//	if err != nil {
+//		log.Warn("couldn't get config", "error", err)
+//	}
+func isFallThroughPattern(ifStmt *ast.IfStmt, info *types.Info, methods map[string]bool) bool {
+	// Only the plain form; an else branch is handled by
+	// isErrorDemotionPattern or is deliberate control flow
+	if ifStmt.Else != nil {
+		return false
+	}
+
+	// Condition must be "err != nil"
+	cond, ok := ifStmt.Cond.(*ast.BinaryExpr)
+	if !ok || cond.Op != token.NEQ || !isErrCondition(info, cond) {
+		return false
+	}
+
+	// The body must log and must not leave the enclosing block, so the
+	// code after the if runs with the zero value
+	return containsLogCall(info, ifStmt.Body, methods) && !leavesBlock(ifStmt.Body)
+}
+
+// demotingErrorCases returns the clauses of a tagless switch that test
+// err != nil and only log, so execution continues after the switch:
+//
+//	switch {
+//	case err != nil:
+//		log.Warn("couldn't get config", "error", err)
+//	case cfg.Enabled:
+//		...
+//	}
+//
+// Clauses that return, panic or branch are deliberate control flow.
+func demotingErrorCases(switchStmt *ast.SwitchStmt, info *types.Info, methods map[string]bool) []*ast.CaseClause {
+	if switchStmt.Tag != nil {
+		return nil
+	}
+
+	var clauses []*ast.CaseClause
+	for _, stmt := range switchStmt.Body.List {
+		clause, ok := stmt.(*ast.CaseClause)
+		if !ok || !hasErrNotNilCase(info, clause) {
+			continue
+		}
+		body := &ast.BlockStmt{List: clause.Body}
+		if containsLogCall(info, body, methods) && !leavesBlock(body) {
+			clauses = append(clauses, clause)
+		}
+	}
+	return clauses
+}
+
+// hasErrNotNilCase checks if one of a case clause's expressions is
+// "err != nil" on an error variable
+func hasErrNotNilCase(info *types.Info, clause *ast.CaseClause) bool {
+	for _, expr := range clause.List {
+		if cond, ok := expr.(*ast.BinaryExpr); ok && cond.Op == token.NEQ && isErrCondition(info, cond) {
+			return true
+		}
+	}
+	return false
+}
+
+// loopContinueDemotions returns the error checks directly in a loop body
+// that log and skip to the next iteration, dropping the error:
+//
+//	for _, name := range names {
+//		cfg, err := load(name)
+//		if err != nil {
+//			log.Warn("couldn't load config", "error", err)
+//			continue
+//		}
+//		...
+//	}
+//
+// The check must end with continue and must not otherwise return, panic
+// or branch.
+func loopContinueDemotions(body *ast.BlockStmt, info *types.Info, methods map[string]bool) []*ast.IfStmt {
+	var demotions []*ast.IfStmt
+	for _, stmt := range body.List {
+		ifStmt, ok := stmt.(*ast.IfStmt)
+		if !ok || ifStmt.Else != nil || len(ifStmt.Body.List) == 0 {
+			continue
+		}
+
+		cond, ok := ifStmt.Cond.(*ast.BinaryExpr)
+		if !ok || cond.Op != token.NEQ || !isErrCondition(info, cond) {
+			continue
+		}
+
+		last := len(ifStmt.Body.List) - 1
+		branch, ok := ifStmt.Body.List[last].(*ast.BranchStmt)
+		if !ok || branch.Tok != token.CONTINUE {
+			continue
+		}
+
+		rest := &ast.BlockStmt{List: ifStmt.Body.List[:last]}
+		if containsLogCall(info, rest, methods) && !leavesBlock(rest) {
+			demotions = append(demotions, ifStmt)
+		}
+	}
+	return demotions
+}
+
+// leavesBlock checks if a block returns, panics or jumps elsewhere instead
+// of falling through. Only its top-level statements count: a return under
+// a nested if may not run, and a break inside a nested loop or switch only
+// leaves that statement.
+func leavesBlock(block *ast.BlockStmt) bool {
+	for _, stmt := range block.List {
+		if leavesStmt(stmt) {
+			return true
+		}
+	}
+	return false
+}
+
+// leavesStmt checks if a statement always returns, panics or jumps out of
+// the block containing it
+func leavesStmt(stmt ast.Stmt) bool {
+	switch stmt := stmt.(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.BlockStmt:
+		return leavesBlock(stmt)
+	case *ast.LabeledStmt:
+		return leavesStmt(stmt.Stmt)
+	case *ast.IfStmt:
+		// Only when both branches leave
+		return stmt.Else != nil && leavesBlock(stmt.Body) && leavesStmt(stmt.Else)
+	case *ast.ExprStmt:
+		call, ok := stmt.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "panic" {
+			return true
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Exit" {
+			return true // os.Exit
+		}
+	}
+	return false
+}
+
 // isErrCondition checks if the condition is testing an error variable
-func isErrCondition(cond ast.Expr) bool {
+func isErrCondition(info *types.Info, cond ast.Expr) bool {
 	switch expr := cond.(type) {
 	case *ast.BinaryExpr:
 		// err == nil or err != nil
-		if (expr.Op == token.EQL || expr.Op == token.NEQ) && 
-		   (isNilIdent(expr.Y) || isNilIdent(expr.X)) {
-			// Check if the other side is an error variable
-			if ident, ok := expr.X.(*ast.Ident); ok && strings.Contains(ident.Name, "err") {
-				return true
-			}
-			if ident, ok := expr.Y.(*ast.Ident); ok && strings.Contains(ident.Name, "err") {
-				return true
-			}
+		if expr.Op != token.EQL && expr.Op != token.NEQ {
+			return false
+		}
+		// Check if the other side is an error variable
+		if isNilIdent(expr.Y) {
+			return isErrorVar(info, expr.X)
+		}
+		if isNilIdent(expr.X) {
+			return isErrorVar(info, expr.Y)
 		}
 	}
 	return false
 }
 
+// isErrorVar checks if an expression is a variable holding an error
+func isErrorVar(info *types.Info, expr ast.Expr) bool {
+	_, ok := expr.(*ast.Ident)
+	return ok && isErrorExpr(info, expr)
+}
+
+// isErrorExpr checks if an expression is an error value. With type
+// information its type must implement error, so an error named e is found
+// and an int named errCount is not; without it, falls back to identifiers
+// whose name contains "err".
+func isErrorExpr(info *types.Info, expr ast.Expr) bool {
+	if info != nil {
+		if t := info.TypeOf(expr); t != nil {
+			if basic, ok := t.(*types.Basic); ok && basic.Kind() == types.UntypedNil {
+				return false
+			}
+			return types.Implements(t, errorType)
+		}
+	}
+	ident, ok := expr.(*ast.Ident)
+	return ok && strings.Contains(ident.Name, "err")
+}
+
 // isNilIdent checks if an expression is the "nil" identifier
 func isNilIdent(expr ast.Expr) bool {
 	ident, ok := expr.(*ast.Ident)
 	return ok && ident.Name == "nil"
 }
 
-// containsLogCall checks if a statement contains a log call
-func containsLogCall(stmt ast.Stmt) bool {
+// containsLogCall checks if a statement calls one of the configured log
+// methods, as a method (log.Info) or a package-level function
+// (log.Printf). Calls into package fmt, such as fmt.Println, only count as
+// pseudo-logging when an error is among the arguments.
+func containsLogCall(info *types.Info, stmt ast.Stmt, methods map[string]bool) bool {
 	hasLog := false
 	ast.Inspect(stmt, func(n ast.Node) bool {
 		if call, ok := n.(*ast.CallExpr); ok {
 			if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
-				// Common logging methods
-				logMethods := map[string]bool{
-					"Info":    true,
-					"Debug":   true,
-					"Warn":    true,
-					"Warning": true,
-					"Trace":   true,
-					"V":       true, // klog verbosity
-				}
-				if logMethods[sel.Sel.Name] {
+				if methods[sel.Sel.Name] && (!isPackage(info, sel.X, "fmt") || hasErrorArg(info, call)) {
 					hasLog = true
 					return false
 				}
@@ -182,14 +622,49 @@ func containsLogCall(stmt ast.Stmt) bool {
 	return hasLog
 }
 
-// containsErrorReturn checks if a statement returns an error
-func containsErrorReturn(stmt ast.Stmt) bool {
+// isPackage checks if an expression names the imported package with the
+// given path. Without type information the identifier is compared with
+// the path instead.
+func isPackage(info *types.Info, expr ast.Expr, path string) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	if info != nil {
+		if pkgName, ok := info.Uses[ident].(*types.PkgName); ok {
+			return pkgName.Imported().Path() == path
+		}
+		if info.Uses[ident] != nil {
+			return false
+		}
+	}
+	return ident.Name == path
+}
+
+// hasErrorArg checks if any argument of a call refers to an error
+// variable, directly (err) or within an expression (err.Error())
+func hasErrorArg(info *types.Info, call *ast.CallExpr) bool {
+	found := false
+	for _, arg := range call.Args {
+		ast.Inspect(arg, func(n ast.Node) bool {
+			if expr, ok := n.(ast.Expr); ok && !found && isErrorVar(info, expr) {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+// containsErrorReturn checks if a statement returns an error, either the
+// caught one or a new one such as fmt.Errorf("...: %w", err)
+func containsErrorReturn(info *types.Info, stmt ast.Stmt) bool {
 	hasReturn := false
 	ast.Inspect(stmt, func(n ast.Node) bool {
 		if ret, ok := n.(*ast.ReturnStmt); ok {
-			// Check if any return value contains "err"
+			// Check if any return value is a non-nil error
 			for _, result := range ret.Results {
-				if ident, ok := result.(*ast.Ident); ok && strings.Contains(ident.Name, "err") {
+				if !isNilIdent(result) && isErrorExpr(info, result) {
 					hasReturn = true
 					return false
 				}
@@ -210,7 +685,7 @@ func hasNolintComment(pass *analysis.Pass, pos token.Pos) bool {
 	line := file.Line(pos)
 	
 	// Check current line and previous line
-	for _, commentGroup := range pass.Files[0].Comments {
+	for _, commentGroup := range fileComments(pass, pos) {
 		for _, comment := range commentGroup.List {
 			commentLine := file.Line(comment.Pos())
 			if commentLine == line || commentLine == line-1 {
@@ -227,6 +702,7 @@ func hasNolintComment(pass *analysis.Pass, pos token.Pos) bool {
 }
 
 // hasResilienceDoc checks if there's explicit documentation about resilience
+// in the 3 lines before the statement
 func hasResilienceDoc(pass *analysis.Pass, pos token.Pos) bool {
 	file := pass.Fset.File(pos)
 	if file == nil {
@@ -234,33 +710,54 @@ func hasResilienceDoc(pass *analysis.Pass, pos token.Pos) bool {
 	}
 
 	line := file.Line(pos)
-	
-	// Check for comments in the 3 lines before the if statement
-	for _, commentGroup := range pass.Files[0].Comments {
+	keywords := parseResilienceKeywords()
+
+	for _, commentGroup := range fileComments(pass, pos) {
 		for _, comment := range commentGroup.List {
 			commentLine := file.Line(comment.Pos())
-			if commentLine >= line-3 && commentLine < line {
-				text := strings.ToLower(comment.Text)
-				// Look for keywords indicating explicit resilience decision
-				keywords := []string{
-					"resilience:",
-					"resilient:",
-					"non-critical",
-					"optional",
-					"safe to ignore",
-					"safe to continue",
-					"safe default",
-					"may not exist",
-				}
-				for _, keyword := range keywords {
-					if strings.Contains(text, keyword) {
-						return true
-					}
-				}
+			if commentLine >= line-3 && commentLine < line && documentsResilience(comment.Text, keywords) {
+				return true
 			}
 		}
 	}
-	
+
 	return false
 }
 
+// documentsResilience reports whether a comment contains one of the
+// keywords, ignoring case, or with -require-prefix whether one of its lines
+// starts with a keyword, matching case
+func documentsResilience(text string, keywords []string) bool {
+	if !requirePrefix {
+		text = strings.ToLower(text)
+		for _, keyword := range keywords {
+			if strings.Contains(text, strings.ToLower(keyword)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	text = strings.TrimPrefix(text, "//")
+	text = strings.TrimPrefix(text, "/*")
+	text = strings.TrimSuffix(text, "*/")
+	for _, commentLine := range strings.Split(text, "\n") {
+		commentLine = strings.TrimLeft(strings.TrimSpace(commentLine), "* ")
+		for _, keyword := range keywords {
+			if strings.HasPrefix(commentLine, keyword) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fileComments returns the comments of the package file containing pos
+func fileComments(pass *analysis.Pass, pos token.Pos) []*ast.CommentGroup {
+	for _, file := range pass.Files {
+		if file.FileStart <= pos && pos <= file.FileEnd {
+			return file.Comments
+		}
+	}
+	return nil
+}
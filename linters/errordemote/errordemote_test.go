@@ -0,0 +1,134 @@
+package errordemote_test
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/opendatahub-io/odh-linter/linters/errordemote"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// setFlag sets an analyzer flag for the duration of a test
+func setFlag(t *testing.T, name, value string) {
+	t.Helper()
+	flag := errordemote.Analyzer.Flags.Lookup(name)
+	if flag == nil {
+		t.Fatalf("unknown flag -%s", name)
+	}
+	previous := flag.Value.String()
+	if err := flag.Value.Set(value); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := flag.Value.Set(previous); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestBlankErr(t *testing.T) {
+	setFlag(t, "blankerr", "true")
+	analysistest.Run(t, analysistest.TestData(), errordemote.Analyzer, "blankerr")
+}
+
+func TestBlankErrDisabled(t *testing.T) {
+	if got := reportedFiles(t, "blankerr"); len(got) != 0 {
+		t.Errorf("diagnostics in %v without -blankerr, want none", got)
+	}
+}
+
+func TestExcludeGenerated(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), errordemote.Analyzer, "generated")
+}
+
+func TestIncludeGenerated(t *testing.T) {
+	setFlag(t, "exclude-generated", "false")
+	want := []string{"config.go", "zz_generated.config.go"}
+	if got := reportedFiles(t, "generated"); !slices.Equal(got, want) {
+		t.Errorf("diagnostics in %v, want %v", got, want)
+	}
+}
+
+func TestExcludePattern(t *testing.T) {
+	setFlag(t, "exclude-generated", "false")
+	setFlag(t, "exclude", "zz_generated.*.go")
+	want := []string{"config.go"}
+	if got := reportedFiles(t, "generated"); !slices.Equal(got, want) {
+		t.Errorf("diagnostics in %v, want %v", got, want)
+	}
+}
+
+// reportedFiles analyzes a testdata package, ignoring its // want markers,
+// and returns the sorted base names of the files with diagnostics
+func reportedFiles(t *testing.T, pkg string) []string {
+	t.Helper()
+
+	results := analysistest.Run(&ignoreExpectations{t}, analysistest.TestData(), errordemote.Analyzer, pkg)
+	if len(results) == 0 {
+		t.Fatalf("the %s package was not analyzed", pkg)
+	}
+	var files []string
+	for _, result := range results {
+		for _, diagnostic := range result.Diagnostics {
+			files = append(files, filepath.Base(result.Pass.Fset.Position(diagnostic.Pos).Filename))
+		}
+	}
+	slices.Sort(files)
+	return slices.Compact(files)
+}
+
+// ignoreExpectations is an analysistest.Testing that discards mismatches
+// with the testdata's // want markers
+type ignoreExpectations struct{ *testing.T }
+
+func (ignoreExpectations) Errorf(format string, args ...interface{}) {}
+
+func TestDefaultLogMethods(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), errordemote.Analyzer, "logmethods")
+}
+
+func TestCustomLogMethods(t *testing.T) {
+	setFlag(t, "logmethods", "Logf, RecordWarning")
+	analysistest.Run(t, analysistest.TestData(), errordemote.Analyzer, "customlogmethods")
+}
+
+func TestFallThrough(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), errordemote.Analyzer, "fallthroughform")
+}
+
+func TestTypeAware(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), errordemote.Analyzer, "typeaware")
+}
+
+func TestNolintFix(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), errordemote.Analyzer, "nolintfix")
+}
+
+func TestFmtLogging(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), errordemote.Analyzer, "fmtlogging")
+}
+
+func TestSwitchAndLoop(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), errordemote.Analyzer, "switchloop")
+}
+
+func TestResilienceKeywords(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), errordemote.Analyzer, "resilience")
+}
+
+func TestCustomResilienceKeywords(t *testing.T) {
+	setFlag(t, "resilience-keywords", "BEST-EFFORT")
+	analysistest.Run(t, analysistest.TestData(), errordemote.Analyzer, "resiliencecustom")
+}
+
+func TestRequirePrefix(t *testing.T) {
+	setFlag(t, "require-prefix", "true")
+	analysistest.Run(t, analysistest.TestData(), errordemote.Analyzer, "resilienceprefix")
+}
+
+func TestRequireCustomPrefix(t *testing.T) {
+	setFlag(t, "require-prefix", "true")
+	setFlag(t, "resilience-keywords", "FALLBACK:")
+	analysistest.Run(t, analysistest.TestData(), errordemote.Analyzer, "resilienceprefixcustom")
+}
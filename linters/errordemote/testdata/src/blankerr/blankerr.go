@@ -0,0 +1,57 @@
+package blankerr
+
+import (
+	"os"
+	"strconv"
+)
+
+func getConfig() (string, error) { return "", nil }
+
+func count() (int, int) { return 0, 0 }
+
+func valueDiscarded() {
+	_, _ = getConfig() // want "error returned by getConfig discarded with blank identifier"
+}
+
+func errorDiscarded() string {
+	value, _ := getConfig() // want "error returned by getConfig discarded with blank identifier"
+	return value
+}
+
+func onlyResultDiscarded() {
+	_ = os.Remove("config.yaml") // want "error returned by os.Remove discarded with blank identifier"
+}
+
+func parallelAssignment() string {
+	var value string
+	value, _ = "default", os.Remove("config.yaml") // want "error returned by os.Remove discarded with blank identifier"
+	return value
+}
+
+func errorHandled() (string, error) {
+	value, err := getConfig()
+	if err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// Discarded values that are not errors
+func notAnError() int {
+	total, _ := count()
+	_ = strconv.Itoa(total)
+	_, ok := map[string]int{}["config"]
+	if ok {
+		return 0
+	}
+	return total
+}
+
+func justified() string {
+	//nolint:errordemote // the file is recreated on the next sync
+	_ = os.Remove("config.yaml")
+
+	// RESILIENCE: an empty value selects the built-in defaults
+	value, _ := getConfig()
+	return value
+}
@@ -0,0 +1,35 @@
+package customlogmethods
+
+type recorder struct{}
+
+func (recorder) Info(msg string, args ...interface{})    {}
+func (recorder) Logf(format string, args ...interface{}) {}
+func (recorder) RecordWarning(reason string, err error)  {}
+
+func getConfig() (string, error) { return "", nil }
+
+func logf(r recorder) string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		r.Logf("couldn't get config: %v", err)
+	}
+	return value
+}
+
+func recordWarning(r recorder) string {
+	if value, err := getConfig(); err == nil { // want "error demoted to log statement instead of being returned"
+		return value
+	} else {
+		r.RecordWarning("ConfigMissing", err)
+	}
+	return ""
+}
+
+// -logmethods replaces the default list, so Info is no longer a log call
+func info(r recorder) string {
+	value, err := getConfig()
+	if err != nil {
+		r.Info("couldn't get config", "error", err)
+	}
+	return value
+}
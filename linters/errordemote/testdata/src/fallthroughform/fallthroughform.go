@@ -0,0 +1,154 @@
+package fallthroughform
+
+import (
+	"errors"
+	"log"
+	"os"
+)
+
+func getConfig() (string, error) { return "", nil }
+
+func loggedAndContinued() string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func loggedWithOtherStatements() string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		value = "default"
+		log.Printf("couldn't get config, using %s: %v", value, err)
+	}
+	return value
+}
+
+func returned() (string, error) {
+	value, err := getConfig()
+	if err != nil {
+		log.Println("couldn't get config:", err)
+		return "", err
+	}
+	return value, nil
+}
+
+func wrapped() (string, error) {
+	value, err := getConfig()
+	if err != nil {
+		log.Println("couldn't get config:", err)
+		return "", errors.Join(errors.New("get config"), err)
+	}
+	return value, nil
+}
+
+func panicked() string {
+	value, err := getConfig()
+	if err != nil {
+		log.Println("couldn't get config:", err)
+		panic(err)
+	}
+	return value
+}
+
+func exited() string {
+	value, err := getConfig()
+	if err != nil {
+		log.Println("couldn't get config:", err)
+		os.Exit(1)
+	}
+	return value
+}
+
+// A return inside a function literal does not leave the if
+func returnInClosure() string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+		_ = func() error { return err }
+	}
+	return value
+}
+
+// A break that only leaves a nested loop does not leave the if
+func nestedBreak(retries []string) string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Printf("couldn't get config: %v", err)
+		for _, retry := range retries {
+			if retry == "" {
+				break
+			}
+		}
+	}
+	return value
+}
+
+// A break that only leaves a nested switch does not leave the if
+func nestedSwitchBreak(mode string) string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Printf("couldn't get config: %v", err)
+		switch mode {
+		case "strict":
+			break
+		}
+	}
+	return value
+}
+
+// A return that may not run does not leave the if
+func conditionalReturn(verbose bool) string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Printf("couldn't get config: %v", err)
+		if verbose {
+			return ""
+		}
+	}
+	return value
+}
+
+// Returning from both branches of a nested if always leaves
+func returnedFromBothBranches(verbose bool) string {
+	value, err := getConfig()
+	if err != nil {
+		if verbose {
+			log.Printf("couldn't get config: %v", err)
+			return "verbose"
+		} else {
+			log.Println("couldn't get config")
+			return ""
+		}
+	}
+	return value
+}
+
+// err == nil is not an error check
+func successCheck() string {
+	value, err := getConfig()
+	if err == nil {
+		log.Println("got config")
+	}
+	return value
+}
+
+// The else form is reported once, by the demotion pattern
+func withElse() string {
+	value, err := getConfig()
+	if err != nil {
+		log.Println("couldn't get config:", err)
+	} else {
+		log.Println("got config")
+	}
+	return value
+}
+
+// An int named like an error is not an error
+func notAnError() {
+	errCount := len(os.Args)
+	if errCount != 0 {
+		log.Println("errors:", errCount)
+	}
+}
@@ -0,0 +1,62 @@
+package fmtlogging
+
+import (
+	"fmt"
+	"log"
+)
+
+type logger struct{}
+
+func (logger) Printf(format string, args ...interface{}) {}
+
+func getConfig() (string, error) { return "", nil }
+
+func standardLog() string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Printf("couldn't get config: %v", err)
+	}
+	return value
+}
+
+func loggerPrintf(l logger) string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		l.Printf("couldn't get config: %v", err)
+	}
+	return value
+}
+
+func fmtPrintln() string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		fmt.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func fmtPrintfErrorString() string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		fmt.Printf("couldn't get config: %s\n", err.Error())
+	}
+	return value
+}
+
+// fmt output without the error is not pseudo-logging of it
+func fmtWithoutError() string {
+	value, err := getConfig()
+	if err != nil {
+		fmt.Println("using the default config")
+	}
+	return value
+}
+
+// Building a message is not logging
+func fmtSprintf() string {
+	value, err := getConfig()
+	if err != nil {
+		value = fmt.Sprintf("default (%v)", err)
+	}
+	return value
+}
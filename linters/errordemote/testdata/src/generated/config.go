@@ -0,0 +1,13 @@
+package generated
+
+import "log"
+
+func getConfig() (string, error) { return "", nil }
+
+func loadConfig() string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
@@ -0,0 +1,49 @@
+package logmethods
+
+import "log"
+
+type logger struct{}
+
+func (logger) Info(msg string, args ...interface{})    {}
+func (logger) Logf(format string, args ...interface{}) {}
+func (logger) RecordWarning(reason string, err error)  {}
+func (logger) Error(msg string, args ...interface{})   {}
+
+func getConfig() (string, error) { return "", nil }
+
+func methodCall(l logger) string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		l.Info("couldn't get config", "error", err)
+	}
+	return value
+}
+
+func packageFunction() string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Printf("couldn't get config: %v", err)
+	}
+	return value
+}
+
+// Logf and RecordWarning are not log methods by default
+func notByDefault(l logger) string {
+	value, err := getConfig()
+	if err != nil {
+		l.Logf("couldn't get config: %v", err)
+	}
+	if err != nil {
+		l.RecordWarning("ConfigMissing", err)
+	}
+	return value
+}
+
+// Error is not a demotion
+func errorLevel(l logger) string {
+	value, err := getConfig()
+	if err != nil {
+		l.Error("couldn't get config", "error", err)
+	}
+	return value
+}
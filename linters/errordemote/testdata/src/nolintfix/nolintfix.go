@@ -0,0 +1,45 @@
+package nolintfix
+
+import "log"
+
+func getConfig() (string, error) { return "", nil }
+
+func topLevel() string {
+	value, err := getConfig()
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func nested(enabled bool) string {
+	if enabled {
+		if value, err := getConfig(); err == nil { // want "error demoted to log statement instead of being returned"
+			return value
+		} else {
+			log.Println("couldn't get config:", err)
+		}
+	}
+	return ""
+}
+
+// The if of an else-if does not start its line, so it gets no fix
+func elseIf(cached string) string {
+	if cached != "" {
+		return cached
+	} else if value, err := getConfig(); err == nil { // want "error demoted to log statement instead of being returned"
+		return value
+	} else {
+		log.Println("couldn't get config:", err)
+	}
+	return ""
+}
+
+func suppressed() string {
+	value, err := getConfig()
+	//nolint:errordemote // the default is good enough
+	if err != nil {
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
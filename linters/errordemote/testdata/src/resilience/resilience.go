@@ -0,0 +1,52 @@
+package resilience
+
+import "log"
+
+func getConfig() (string, error) { return "", nil }
+
+func keyword() string {
+	value, err := getConfig()
+	// RESILIENCE: the default config works on every cluster
+	if err != nil {
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func keywordInSentence() string {
+	value, err := getConfig()
+	// The config map is optional on vanilla Kubernetes
+	if err != nil {
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func keywordIgnoresCase() string {
+	value, err := getConfig()
+	// Safe To Ignore: the default is used until the next sync
+	if err != nil {
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func undocumented() string {
+	value, err := getConfig()
+	// Continue with the default config
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func keywordTooFarAbove() string {
+	// RESILIENCE: more than three lines above the check
+	value, err := getConfig()
+
+	value = value + ""
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
@@ -0,0 +1,24 @@
+package resiliencecustom
+
+import "log"
+
+func getConfig() (string, error) { return "", nil }
+
+func customKeyword() string {
+	value, err := getConfig()
+	// best-effort: the default config works on every cluster
+	if err != nil {
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+// -resilience-keywords replaces the default list
+func defaultKeyword() string {
+	value, err := getConfig()
+	// RESILIENCE: the default config works on every cluster
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
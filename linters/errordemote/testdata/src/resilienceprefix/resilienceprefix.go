@@ -0,0 +1,72 @@
+package resilienceprefix
+
+import "log"
+
+func getConfig() (string, error) { return "", nil }
+
+func resiliencePrefix() string {
+	value, err := getConfig()
+	// RESILIENCE: the default config works on every cluster
+	if err != nil {
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func resilientPrefix() string {
+	value, err := getConfig()
+	// RESILIENT: the default config works on every cluster
+	if err != nil {
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func prefixOnLaterLine() string {
+	value, err := getConfig()
+	// The config map is missing on vanilla Kubernetes.
+	// RESILIENCE: the default config works on every cluster
+	if err != nil {
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func blockComment() string {
+	value, err := getConfig()
+	/*
+	 * RESILIENCE: the default config works on every cluster
+	 */
+	if err != nil {
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func prefixMatchesCase() string {
+	value, err := getConfig()
+	// resilience: the default config works on every cluster
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func prefixNotAtStart() string {
+	value, err := getConfig()
+	// Note RESILIENCE: the default config works on every cluster
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+// Keywords of the default, substring list are not prefixes
+func substringKeyword() string {
+	value, err := getConfig()
+	// The config map is optional on vanilla Kubernetes
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
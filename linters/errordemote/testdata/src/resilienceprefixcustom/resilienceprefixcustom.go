@@ -0,0 +1,33 @@
+package resilienceprefixcustom
+
+import "log"
+
+func getConfig() (string, error) { return "", nil }
+
+func customPrefix() string {
+	value, err := getConfig()
+	// FALLBACK: the default config works on every cluster
+	if err != nil {
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+func customPrefixMatchesCase() string {
+	value, err := getConfig()
+	// fallback: the default config works on every cluster
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
+
+// -resilience-keywords replaces the default prefixes too
+func defaultPrefix() string {
+	value, err := getConfig()
+	// RESILIENCE: the default config works on every cluster
+	if err != nil { // want "error demoted to log statement instead of being returned"
+		log.Println("couldn't get config:", err)
+	}
+	return value
+}
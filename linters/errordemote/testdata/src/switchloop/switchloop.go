@@ -0,0 +1,138 @@
+package switchloop
+
+import "log"
+
+type config struct{ enabled bool }
+
+func load(name string) (config, error) { return config{}, nil }
+
+func switchCase() config {
+	cfg, err := load("main")
+	switch {
+	case err != nil: // want "error demoted to log statement in switch case instead of being returned"
+		log.Println("couldn't load config:", err)
+	case cfg.enabled:
+		log.Println("config enabled")
+	}
+	return cfg
+}
+
+func switchCaseReturns() (config, error) {
+	cfg, err := load("main")
+	switch {
+	case err != nil:
+		log.Println("couldn't load config:", err)
+		return config{}, err
+	case cfg.enabled:
+		log.Println("config enabled")
+	}
+	return cfg, nil
+}
+
+// A switch with a tag compares values rather than testing conditions
+func taggedSwitch(name string) config {
+	cfg, err := load(name)
+	switch name {
+	case "main":
+		log.Println("loaded main config:", err)
+	}
+	return cfg
+}
+
+func rangeContinue(names []string) []config {
+	var configs []config
+	for _, name := range names {
+		cfg, err := load(name)
+		if err != nil { // want "error demoted to log statement and the loop continues instead of returning it"
+			log.Println("couldn't load config:", err)
+			continue
+		}
+		configs = append(configs, cfg)
+	}
+	return configs
+}
+
+func forContinue(names []string) []config {
+	var configs []config
+	for i := 0; i < len(names); i++ {
+		cfg, err := load(names[i])
+		if err != nil { // want "error demoted to log statement and the loop continues instead of returning it"
+			log.Printf("couldn't load config %s: %v", names[i], err)
+			continue
+		}
+		configs = append(configs, cfg)
+	}
+	return configs
+}
+
+func loopReturns(names []string) ([]config, error) {
+	var configs []config
+	for _, name := range names {
+		cfg, err := load(name)
+		if err != nil {
+			log.Println("couldn't load config:", err)
+			return nil, err
+		}
+		configs = append(configs, cfg)
+	}
+	return configs, nil
+}
+
+// Continuing without logging is not a demotion to a log statement
+func loopSkips(names []string) []config {
+	var configs []config
+	for _, name := range names {
+		cfg, err := load(name)
+		if err != nil {
+			continue
+		}
+		configs = append(configs, cfg)
+	}
+	return configs
+}
+
+func loopSuppressed(names []string) []config {
+	var configs []config
+	for _, name := range names {
+		cfg, err := load(name)
+		// RESILIENCE: a broken config only disables that component
+		if err != nil {
+			log.Println("couldn't load config:", err)
+			continue
+		}
+		configs = append(configs, cfg)
+	}
+	return configs
+}
+
+// A break out of a nested loop before the continue does not leave the check
+func loopNestedBreak(names []string, fallbacks []string) []config {
+	var configs []config
+	for _, name := range names {
+		cfg, err := load(name)
+		if err != nil { // want "error demoted to log statement and the loop continues instead of returning it"
+			log.Println("couldn't load config:", err)
+			for _, fallback := range fallbacks {
+				if fallback == name {
+					break
+				}
+			}
+			continue
+		}
+		configs = append(configs, cfg)
+	}
+	return configs
+}
+
+// fallthrough passes the error on to the next case
+func switchFallthrough() (config, error) {
+	cfg, err := load("main")
+	switch {
+	case err != nil:
+		log.Println("couldn't load config:", err)
+		fallthrough
+	case cfg.enabled:
+		return cfg, err
+	}
+	return cfg, nil
+}
@@ -0,0 +1,76 @@
+package typeaware
+
+import "log"
+
+type notFoundError struct{ name string }
+
+func (e *notFoundError) Error() string { return e.name + " not found" }
+
+func getConfig() (string, error) { return "", nil }
+
+func findConfig() (string, *notFoundError) { return "", nil }
+
+func countErrors() (int, *int) { return 0, nil }
+
+// An error is found by its type, whatever its name
+func shortName() string {
+	value, e := getConfig()
+	if e != nil { // want "error demoted to log statement instead of being returned"
+		log.Println(e)
+	}
+	return value
+}
+
+func shortNameElse() string {
+	if value, e := getConfig(); e == nil { // want "error demoted to log statement instead of being returned"
+		return value
+	} else {
+		log.Println(e)
+	}
+	return ""
+}
+
+func customErrorType() string {
+	value, problem := findConfig()
+	if problem != nil { // want "error demoted to log statement instead of being returned"
+		log.Println(problem)
+	}
+	return value
+}
+
+// Values whose name contains "err" are not errors unless their type is
+func errCount() int {
+	errCount := 0
+	if errCount != 0 {
+		log.Println(errCount)
+	}
+	return errCount
+}
+
+func errPointer() int {
+	total, errTotal := countErrors()
+	if errTotal != nil {
+		log.Println(*errTotal)
+	}
+	return total
+}
+
+// Returning a string named like an error does not return the error
+func errMessageReturned() string {
+	errMessage := "couldn't get config"
+	if value, e := getConfig(); e == nil { // want "error demoted to log statement instead of being returned"
+		return value
+	} else {
+		log.Println(e)
+		return errMessage
+	}
+}
+
+func errorReturned() (string, error) {
+	if value, e := getConfig(); e == nil {
+		return value, nil
+	} else {
+		log.Println(e)
+		return "", e
+	}
+}